@@ -0,0 +1,180 @@
+package music
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// AdaptiveConstraints limits on how far an adaptive tuner may move notes, in cents. MaxDrift bounds how far any note
+// may lie from its pitch in the reference scale and MaxHeldNoteChange how far a note may be retuned while it sounds.
+type AdaptiveConstraints struct {
+	MaxDrift          float64
+	MaxHeldNoteChange float64
+}
+
+// Retuning the offset in cents from twelve-tone equal temperament at which a MIDI note should now sound.
+type Retuning struct {
+	Note   int
+	Offset float64
+}
+
+// AdaptiveTuner retunes the sounding notes of a twelve-tone keyboard so that each chord is as simple a set of just
+// ratios as the constraints allow. Every chord is tried with each of its notes as root, taking the simplest 5-limit
+// ratio above the root for each semitone, and the root giving the lowest total Tenney height that can be placed within
+// the constraints is chosen.
+type AdaptiveTuner struct {
+	reference   [12]float64
+	constraints AdaptiveConstraints
+	sounding    map[int]float64
+}
+
+// adaptiveRatios the simplest just ratio for each number of semitones above a root.
+var adaptiveRatios = IntervalsFromIntegers([][]uint{
+	{1, 1}, {16, 15}, {9, 8}, {6, 5}, {5, 4}, {4, 3}, {45, 32}, {3, 2}, {8, 5}, {5, 3}, {9, 5}, {15, 8},
+})
+
+// NewAdaptiveTuner takes the twelve-note reference scale with its first degree on the given MIDI pitch class. It fails
+// for negative constraints, which no placement could meet.
+func NewAdaptiveTuner(reference CentsScale, tonic int, constraints AdaptiveConstraints) (*AdaptiveTuner, error) {
+	if !(constraints.MaxDrift >= 0) {
+		return nil, fmt.Errorf("adaptive tuning needs a maximum drift of zero or more but was given %g cents", constraints.MaxDrift)
+	}
+	if !(constraints.MaxHeldNoteChange >= 0) {
+		return nil, fmt.Errorf("adaptive tuning needs a maximum held note change of zero or more but was given %g cents", constraints.MaxHeldNoteChange)
+	}
+	cents := centsWithoutOctave(reference.Cents())
+	if len(cents) != 12 {
+		return nil, fmt.Errorf("adaptive tuning needs a twelve-note reference scale but %s has %d notes", reference.System(), len(cents))
+	}
+	tuner := &AdaptiveTuner{constraints: constraints, sounding: map[int]float64{}}
+	for degree, c := range cents {
+		tuner.reference[wrapDegree(degree+tonic, 12)] = c - float64(degree)*100
+	}
+	return tuner, nil
+}
+
+// Offset the offset in cents from twelve-tone equal temperament at which a sounding note is tuned.
+func (t *AdaptiveTuner) Offset(note int) (float64, bool) {
+	offset, ok := t.sounding[note]
+	return toDecimalPlaces(offset, 2), ok
+}
+
+// NoteOn sounds a note and returns the retuning of it and of every sounding note whose offset changed, by note number.
+func (t *AdaptiveTuner) NoteOn(note int) []Retuning {
+	if _, ok := t.sounding[note]; ok {
+		return nil
+	}
+	held := t.sortedSounding()
+	notes := append(slices.Clone(held), note)
+	slices.Sort(notes)
+
+	offsets, ok := t.bestPlacement(notes, held)
+	if !ok {
+		offsets = map[int]float64{note: t.referenceOffset(note)}
+		for _, h := range held {
+			offsets[h] = t.sounding[h]
+		}
+	}
+
+	var retunings []Retuning
+	for _, n := range notes {
+		offset := toDecimalPlaces(offsets[n], 2)
+		if previous, sounding := t.sounding[n]; !sounding || toDecimalPlaces(previous, 2) != offset {
+			retunings = append(retunings, Retuning{Note: n, Offset: offset})
+		}
+		t.sounding[n] = offsets[n]
+	}
+	return retunings
+}
+
+// NoteOff stops a note; the notes still sounding keep their tuning.
+func (t *AdaptiveTuner) NoteOff(note int) {
+	delete(t.sounding, note)
+}
+
+func (t *AdaptiveTuner) bestPlacement(notes, held []int) (map[int]float64, bool) {
+	type candidate struct {
+		relative map[int]float64
+		height   float64
+	}
+	var candidates []candidate
+	for _, root := range notes {
+		relative, height := justOffsetsAbove(root, notes)
+		candidates = append(candidates, candidate{relative: relative, height: height})
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.height, b.height)
+	})
+
+	for _, c := range candidates {
+		if base, ok := t.placeChord(c.relative, notes, held); ok {
+			offsets := map[int]float64{}
+			for _, n := range notes {
+				offsets[n] = base + c.relative[n]
+			}
+			return offsets, true
+		}
+	}
+	return nil, false
+}
+
+// placeChord finds the shift of the whole chord closest to keeping held notes where they are, or to the reference
+// scale when nothing is held, that keeps every note within the constraints.
+func (t *AdaptiveTuner) placeChord(relative map[int]float64, notes, held []int) (float64, bool) {
+	lowest, highest := math.Inf(-1), math.Inf(1)
+	preferred := 0.0
+	for _, n := range notes {
+		lowest = max(lowest, t.referenceOffset(n)-t.constraints.MaxDrift-relative[n])
+		highest = min(highest, t.referenceOffset(n)+t.constraints.MaxDrift-relative[n])
+		preferred += (t.referenceOffset(n) - relative[n]) / float64(len(notes))
+	}
+	if len(held) > 0 {
+		heldLowest, heldHighest := math.Inf(1), math.Inf(-1)
+		for _, h := range held {
+			lowest = max(lowest, t.sounding[h]-t.constraints.MaxHeldNoteChange-relative[h])
+			highest = min(highest, t.sounding[h]+t.constraints.MaxHeldNoteChange-relative[h])
+			heldLowest = min(heldLowest, t.sounding[h]-relative[h])
+			heldHighest = max(heldHighest, t.sounding[h]-relative[h])
+		}
+		preferred = (heldLowest + heldHighest) / 2
+	}
+	if lowest > highest+1e-9 {
+		return 0, false
+	}
+	return min(max(preferred, lowest), highest), true
+}
+
+// justOffsetsAbove the offset of each note from equal temperament relative to the root when every note is tuned to
+// the simplest ratio above it, and the total Tenney height of every interval in the resulting chord.
+func justOffsetsAbove(root int, notes []int) (map[int]float64, float64) {
+	relative := map[int]float64{}
+	var ratios []JustInterval
+	for _, n := range notes {
+		semitones := n - root
+		ratio := shiftOctaves(adaptiveRatios[wrapDegree(semitones, 12)], int(math.Floor(float64(semitones)/12)))
+		ratios = append(ratios, ratio)
+		relative[n] = ratio.ToCents() - float64(semitones)*100
+	}
+	height := 0.0
+	for i := range ratios {
+		for j := i + 1; j < len(ratios); j++ {
+			height += ratios[j].Subtract(ratios[i]).TenneyHeight()
+		}
+	}
+	return relative, height
+}
+
+func (t *AdaptiveTuner) referenceOffset(note int) float64 {
+	return t.reference[wrapDegree(note, 12)]
+}
+
+func (t *AdaptiveTuner) sortedSounding() []int {
+	var notes []int
+	for n := range t.sounding {
+		notes = append(notes, n)
+	}
+	slices.Sort(notes)
+	return notes
+}
@@ -0,0 +1,137 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	middleC = 60
+	middleE = 64
+	middleG = 67
+)
+
+func Test_ShouldTuneMajorTriadToJustRatiosAsNotesArrive(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 50, MaxHeldNoteChange: 50})
+
+	// When
+	c := tuner.NoteOn(middleC)
+	e := tuner.NoteOn(middleE)
+	g := tuner.NoteOn(middleG)
+
+	// Then
+	assert.Equal(t, []Retuning{{Note: middleC, Offset: 0}}, c)
+	assert.Equal(t, []Retuning{{Note: middleE, Offset: -13.69}}, e)
+	assert.Equal(t, []Retuning{{Note: middleG, Offset: 1.96}}, g)
+}
+
+func Test_ShouldCentreChordOnReferenceWhenNothingIsHeld(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 50, MaxHeldNoteChange: 50})
+	tuner.NoteOn(middleC)
+
+	// When
+	tuner.NoteOff(middleC)
+	e := tuner.NoteOn(middleE)
+
+	// Then
+	assert.Equal(t, []Retuning{{Note: middleE, Offset: 0}}, e)
+	_, sounding := tuner.Offset(middleC)
+	assert.False(t, sounding)
+}
+
+func Test_ShouldMoveHeldNoteToKeepNewNoteWithinMaximumDrift(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 10, MaxHeldNoteChange: 50})
+	tuner.NoteOn(middleC)
+
+	// When
+	retunings := tuner.NoteOn(middleE)
+
+	// Then
+	assert.Equal(t, []Retuning{{Note: middleC, Offset: 3.69}, {Note: middleE, Offset: -10}}, retunings)
+}
+
+func Test_ShouldFallBackToReferenceWhenNoJustPlacementMeetsConstraints(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 10, MaxHeldNoteChange: 0})
+	tuner.NoteOn(middleC)
+
+	// When
+	retunings := tuner.NoteOn(middleE)
+
+	// Then
+	assert.Equal(t, []Retuning{{Note: middleE, Offset: 0}}, retunings)
+	offset, _ := tuner.Offset(middleC)
+	assert.Equal(t, 0.0, offset)
+}
+
+func Test_ShouldTuneFirstNoteToReferenceScale(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewBachWohltemperierteKlavierScale(), 0, AdaptiveConstraints{MaxDrift: 50, MaxHeldNoteChange: 50})
+
+	// When
+	retunings := tuner.NoteOn(middleE)
+
+	// Then
+	assert.Equal(t, []Retuning{{Note: middleE, Offset: 2.85}}, retunings)
+}
+
+func Test_ShouldChooseRootGivingSimplestChord(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 50, MaxHeldNoteChange: 50})
+	tuner.NoteOn(middleE)
+	tuner.NoteOn(middleG)
+
+	// When
+	tuner.NoteOn(middleC)
+
+	// Then
+	c, _ := tuner.Offset(middleC)
+	e, _ := tuner.Offset(middleE)
+	g, _ := tuner.Offset(middleG)
+	assert.InDelta(t, 386.31, e-c+400, 0.01)
+	assert.InDelta(t, 701.96, g-c+700, 0.01)
+}
+
+func Test_ShouldIgnoreRepeatedNoteOn(t *testing.T) {
+	// Given
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 50, MaxHeldNoteChange: 50})
+	tuner.NoteOn(middleC)
+
+	// Then
+	assert.Nil(t, tuner.NoteOn(middleC))
+}
+
+func Test_ShouldRejectReferenceScaleWithoutTwelveNotes(t *testing.T) {
+	// When
+	_, err := NewAdaptiveTuner(NewEqualTemperamentScale(19), 0, AdaptiveConstraints{})
+
+	// Then
+	assert.EqualError(t, err, "adaptive tuning needs a twelve-note reference scale but Equal Temperament has 19 notes")
+}
+
+func BenchmarkAdaptiveTuner_NoteOn(b *testing.B) {
+	tuner, _ := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 30, MaxHeldNoteChange: 5})
+	chord := []int{48, 55, 60, 64, 67, 70}
+	for b.Loop() {
+		for _, note := range chord {
+			tuner.NoteOn(note)
+		}
+		for _, note := range chord {
+			tuner.NoteOff(note)
+		}
+	}
+}
+
+func Test_ShouldRejectNegativeAdaptiveConstraints(t *testing.T) {
+	// When
+	_, drift := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: -1, MaxHeldNoteChange: 50})
+	_, held := NewAdaptiveTuner(NewEqualTemperamentScale(12), 0, AdaptiveConstraints{MaxDrift: 50, MaxHeldNoteChange: -5})
+
+	// Then
+	assert.EqualError(t, drift, "adaptive tuning needs a maximum drift of zero or more but was given -1 cents")
+	assert.EqualError(t, held, "adaptive tuning needs a maximum held note change of zero or more but was given -5 cents")
+}
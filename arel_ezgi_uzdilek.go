@@ -0,0 +1,129 @@
+package music
+
+import (
+	"fmt"
+	"math"
+)
+
+const holdrianCommasInOctave = 53
+
+// NewArelEzgiUzdilekScale the 24-tone Arel-Ezgi-Uzdilek gamut of Turkish makam theory: a chain of Pythagorean fifths
+// from 12 below to 11 above Rast, each tone lying on a step of 53-EDO.
+func NewArelEzgiUzdilekScale() JustScale {
+	return JustScale{
+		system:      "Arel-Ezgi-Uzdilek",
+		description: "Turkish 24-tone Pythagorean gamut measured in Holdrian commas.",
+		algorithm: func() []JustInterval {
+			return computeChainOfFifths(-12, 11)
+		},
+	}
+}
+
+// AEUAccidental an accidental of Arel-Ezgi-Uzdilek notation, raising (diyez) or lowering (bemol) a note by a number
+// of Holdrian commas.
+type AEUAccidental struct {
+	name     string
+	commas   int
+	interval JustInterval
+}
+
+func Koma() AEUAccidental {
+	return AEUAccidental{name: "koma", commas: 1, interval: JustInterval{numerator: 531441, denominator: 524288}}
+}
+
+func Bakiye() AEUAccidental {
+	return AEUAccidental{name: "bakiye", commas: 4, interval: JustInterval{numerator: 256, denominator: 243}}
+}
+
+func KucukMucennep() AEUAccidental {
+	return AEUAccidental{name: "küçük mücennep", commas: 5, interval: JustInterval{numerator: 2187, denominator: 2048}}
+}
+
+func BuyukMucennep() AEUAccidental {
+	return AEUAccidental{name: "büyük mücennep", commas: 8, interval: JustInterval{numerator: 65536, denominator: 59049}}
+}
+
+func (a AEUAccidental) Name() string {
+	return a.name
+}
+
+func (a AEUAccidental) Commas() int {
+	return a.commas
+}
+
+func (a AEUAccidental) Interval() JustInterval {
+	return a.interval
+}
+
+// ToHoldrianCommas returns the nearest whole number of Holdrian commas (53rds of an octave) to the interval.
+func (i JustInterval) ToHoldrianCommas() int {
+	return int(math.Round(i.ToCents() * holdrianCommasInOctave / centsInOctave))
+}
+
+// FromHoldrianCommas returns the Arel-Ezgi-Uzdilek interval lying the given number of Holdrian commas above 1/1.
+func FromHoldrianCommas(commas int) (JustInterval, error) {
+	if commas < 0 {
+		return JustInterval{}, fmt.Errorf("cannot find interval of %d Holdrian commas: commas must not be negative", commas)
+	}
+	interval := Unison()
+	for range commas / holdrianCommasInOctave {
+		interval = interval.Add(Octave())
+	}
+	for _, degree := range computeChainOfFifths(-12, 11) {
+		if degree.ToHoldrianCommas() == commas%holdrianCommasInOctave {
+			return interval.Add(degree), nil
+		}
+	}
+	return JustInterval{}, fmt.Errorf("no Arel-Ezgi-Uzdilek interval lies %d Holdrian commas above 1/1", commas)
+}
+
+var aeuNaturals = []struct {
+	letter string
+	commas int
+}{{"G", 0}, {"A", 9}, {"B", 18}, {"C", 22}, {"D", 31}, {"E", 40}, {"F", 44}, {"G", 53}}
+
+// AEUNoteName names the interval above Rast (G) as a natural note and the Arel-Ezgi-Uzdilek accidental nearest to it,
+// e.g. Segâh (8192/6561) is "B koma bemol" and Nîm Zirgüle (256/243) is "G bakiye diyez". Where a pitch can be
+// spelt more than one way, the smaller accidental is chosen, and diyez wins a tie.
+func AEUNoteName(i JustInterval) string {
+	commas := i.OctaveReduce().Simplify().ToHoldrianCommas()
+	var best string
+	bestDeviation, bestMagnitude := holdrianCommasInOctave, holdrianCommasInOctave
+	for _, natural := range aeuNaturals {
+		for _, accidental := range []AEUAccidental{{}, Koma(), Bakiye(), KucukMucennep(), BuyukMucennep()} {
+			for _, direction := range []int{1, -1} {
+				spelling := natural.commas + direction*accidental.commas
+				deviation := int(math.Abs(float64(commas - spelling)))
+				if deviation < bestDeviation || (deviation == bestDeviation && accidental.commas < bestMagnitude) {
+					best, bestDeviation, bestMagnitude = spell(natural.letter, accidental, direction), deviation, accidental.commas
+				}
+			}
+		}
+	}
+	return best
+}
+
+func spell(letter string, accidental AEUAccidental, direction int) string {
+	if accidental.commas == 0 {
+		return letter
+	}
+	if direction < 0 {
+		return fmt.Sprintf("%s %s bemol", letter, accidental.name)
+	}
+	return fmt.Sprintf("%s %s diyez", letter, accidental.name)
+}
+
+func computeChainOfFifths(fifthsBelow, fifthsAbove int) []JustInterval {
+	var intervals []JustInterval
+	for i := fifthsBelow; i <= fifthsAbove; i++ {
+		if i < 0 {
+			intervals = append(intervals, PerfectFifth().ToPowerOf(i).Reciprocal().OctaveReduce().Simplify())
+		} else {
+			intervals = append(intervals, PerfectFifth().ToPowerOf(i).OctaveReduce().Simplify())
+		}
+	}
+
+	intervals = append(intervals, Octave())
+	SortIntervals(intervals)
+	return intervals
+}
@@ -0,0 +1,129 @@
+package music
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldReturnArelEzgiUzdilek24ToneScale(t *testing.T) {
+	// Given
+	scale := NewArelEzgiUzdilekScale()
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Arel-Ezgi-Uzdilek", scale.System())
+	assert.Equal(t, "Turkish 24-tone Pythagorean gamut measured in Holdrian commas.", scale.Description())
+	assert.Equal(t, 25, len(intervals))
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, intervals[0])
+	assert.Equal(t, JustInterval{numerator: 256, denominator: 243}, intervals[1])
+	assert.Equal(t, JustInterval{numerator: 2187, denominator: 2048}, intervals[2])
+	assert.Equal(t, JustInterval{numerator: 65536, denominator: 59049}, intervals[3])
+	assert.Equal(t, JustInterval{numerator: 9, denominator: 8}, intervals[4])
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[24])
+
+	var commas []int
+	for _, interval := range intervals {
+		commas = append(commas, interval.ToHoldrianCommas())
+	}
+	assert.Equal(t, []int{0, 4, 5, 8, 9, 13, 14, 17, 18, 22, 23, 26, 27, 30, 31, 35, 36, 39, 40, 44, 45, 48, 49, 52, 53}, commas)
+}
+
+func TestInterval_ToHoldrianCommas(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval JustInterval
+		want     int
+	}{
+		{
+			name:     "Pythagorean comma is one koma",
+			interval: Koma().Interval(),
+			want:     1,
+		},
+		{
+			name:     "Pythagorean whole tone is nine commas",
+			interval: GreaterMajorSecond(),
+			want:     9,
+		},
+		{
+			name:     "Perfect fifth is thirty-one commas",
+			interval: PerfectFifth(),
+			want:     31,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.interval.ToHoldrianCommas(); got != tt.want {
+				t.Errorf("ToHoldrianCommas() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_FromHoldrianCommas(t *testing.T) {
+	tests := []struct {
+		name    string
+		commas  int
+		want    JustInterval
+		wantErr bool
+	}{
+		{
+			name:   "Nine commas is a Pythagorean whole tone",
+			commas: 9,
+			want:   JustInterval{numerator: 9, denominator: 8},
+		},
+		{
+			name:   "Seventy-five commas is a perfect fourth above the octave",
+			commas: 75,
+			want:   JustInterval{numerator: 8, denominator: 3},
+		},
+		{
+			name:    "Two commas is not in the gamut",
+			commas:  2,
+			wantErr: true,
+		},
+		{
+			name:    "Negative commas are rejected",
+			commas:  -4,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromHoldrianCommas(tt.commas)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FromHoldrianCommas() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromHoldrianCommas() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_AEUNoteName(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval JustInterval
+		want     string
+	}{
+		{name: "Rast", interval: Unison(), want: "G"},
+		{name: "Nevâ", interval: PerfectFifth(), want: "D"},
+		{name: "Segâh", interval: JustInterval{numerator: 8192, denominator: 6561}, want: "B koma bemol"},
+		{name: "Nîm Zirgüle", interval: JustInterval{numerator: 256, denominator: 243}, want: "G bakiye diyez"},
+		{name: "Dik Zirgüle", interval: BuyukMucennep().Interval(), want: "A koma bemol"},
+		{name: "Eviç", interval: JustInterval{numerator: 4096, denominator: 2187}, want: "F bakiye diyez"},
+		{name: "Septimal minor seventh is nearest to a koma below Acem", interval: NewInterval(7, 4), want: "F koma bemol"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AEUNoteName(tt.interval); got != tt.want {
+				t.Errorf("AEUNoteName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
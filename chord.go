@@ -0,0 +1,414 @@
+package music
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Triad, Seventh, Ninth, Eleventh and Thirteenth are stacks of thirds as steps above a root in a seven-note scale.
+func Triad() []int {
+	return []int{0, 2, 4}
+}
+
+func Seventh() []int {
+	return []int{0, 2, 4, 6}
+}
+
+func Ninth() []int {
+	return []int{0, 2, 4, 6, 8}
+}
+
+func Eleventh() []int {
+	return []int{0, 2, 4, 6, 8, 10}
+}
+
+func Thirteenth() []int {
+	return []int{0, 2, 4, 6, 8, 10, 12}
+}
+
+// Voicing how the notes of a chord are spread across octaves.
+type Voicing string
+
+const (
+	// ClosedVoicing packs every note within the octave above the bass.
+	ClosedVoicing Voicing = "closed"
+	// OpenVoicing takes the closed voicing and raises every second note from the bass by an octave.
+	OpenVoicing Voicing = "open"
+)
+
+func (v Voicing) String() string {
+	return string(v)
+}
+
+// JustChord notes as just intervals above a tonic, lowest first, with the note the chord is built on as its root.
+type JustChord struct {
+	root  JustInterval
+	notes []JustInterval
+}
+
+// NewJustChord the chord of the given notes above a tonic, taking the first as its root.
+func NewJustChord(notes ...JustInterval) JustChord {
+	chord := JustChord{notes: slices.Clone(notes)}
+	if len(notes) > 0 {
+		chord.root = notes[0]
+	}
+	SortIntervals(chord.notes)
+	return chord
+}
+
+// NewOtonalChord the chord of the given harmonics of a common fundamental, so 4, 5, 6, 7 is the harmonic seventh.
+func NewOtonalChord(harmonics ...uint) JustChord {
+	var notes []JustInterval
+	for _, harmonic := range harmonics {
+		notes = append(notes, NewInterval(harmonic, harmonics[0]))
+	}
+	return NewJustChord(notes...)
+}
+
+// NewUtonalChord the chord of the given subharmonics of a common overtone, listed from the lowest note, so 6, 5, 4 is
+// the just minor triad.
+func NewUtonalChord(subharmonics ...uint) JustChord {
+	var notes []JustInterval
+	for _, subharmonic := range subharmonics {
+		notes = append(notes, NewInterval(subharmonics[0], subharmonic))
+	}
+	return NewJustChord(notes...)
+}
+
+// Chord the chord built on a degree of the scale from the given steps above it, wrapping into higher octaves past the
+// end of the scale, so s.Chord(1, Triad()...) is the triad on the second degree.
+func (s JustScale) Chord(root int, steps ...int) (JustChord, error) {
+	degrees := withoutOctave(s.Intervals())
+	if len(degrees) == 0 {
+		return JustChord{}, fmt.Errorf("cannot build a chord from %s as it has no degrees", s.system)
+	}
+	var notes []JustInterval
+	for _, step := range steps {
+		degree := root + step
+		octaves := int(math.Floor(float64(degree) / float64(len(degrees))))
+		notes = append(notes, shiftOctaves(degrees[wrapDegree(degree, len(degrees))], octaves))
+	}
+	chord := NewJustChord(notes...)
+	chord.root = shiftOctaves(degrees[wrapDegree(root, len(degrees))], int(math.Floor(float64(root)/float64(len(degrees)))))
+	return chord, nil
+}
+
+func (c JustChord) Root() JustInterval {
+	return c.root
+}
+
+func (c JustChord) Notes() []JustInterval {
+	return c.notes
+}
+
+// Intervals returns each note's interval above the bass.
+func (c JustChord) Intervals() []JustInterval {
+	var intervals []JustInterval
+	for _, note := range c.notes {
+		intervals = append(intervals, note.Subtract(c.notes[0]))
+	}
+	return intervals
+}
+
+func (c JustChord) Frequencies(tonic float64) []float64 {
+	var frequencies []float64
+	for _, note := range c.notes {
+		frequencies = append(frequencies, tonic*note.ToFloat())
+	}
+	return frequencies
+}
+
+// Invert moves the bass up by octaves to above the top note as many times as the inversion asked for.
+func (c JustChord) Invert(inversion int) JustChord {
+	if len(c.notes) == 0 {
+		return c
+	}
+	notes := slices.Clone(c.notes)
+	for range inversion {
+		bass := notes[0]
+		for !bass.GreaterThan(notes[len(notes)-1]) {
+			bass = bass.Add(Octave())
+		}
+		notes = append(notes[1:], bass)
+	}
+	return JustChord{root: c.root, notes: notes}
+}
+
+func (c JustChord) Voice(voicing Voicing) JustChord {
+	if len(c.notes) == 0 {
+		return c
+	}
+	bass := c.notes[0]
+	notes := []JustInterval{bass}
+	for _, note := range c.notes[1:] {
+		notes = appendIfMissing(notes, bass.Add(note.Subtract(bass).OctaveReduce().Simplify()))
+	}
+	SortIntervals(notes)
+	if voicing == OpenVoicing {
+		for i := 1; i < len(notes); i += 2 {
+			notes[i] = notes[i].Add(Octave())
+		}
+		SortIntervals(notes)
+	}
+	return JustChord{root: c.root, notes: notes}
+}
+
+// Transpose moves every note, and the root, up by the interval.
+func (c JustChord) Transpose(interval JustInterval) JustChord {
+	notes := make([]JustInterval, len(c.notes))
+	for i, note := range c.notes {
+		notes[i] = note.Add(interval)
+	}
+	return JustChord{root: c.root.Add(interval), notes: notes}
+}
+
+// TransposeToDegree moves the chord unchanged so that its root falls on the given degree of the scale.
+func (c JustChord) TransposeToDegree(scale JustScale, degree int) (JustChord, error) {
+	degrees := withoutOctave(scale.Intervals())
+	if len(degrees) == 0 {
+		return JustChord{}, fmt.Errorf("cannot transpose to a degree of %s as it has no degrees", scale.system)
+	}
+	target := shiftOctaves(degrees[wrapDegree(degree, len(degrees))], int(math.Floor(float64(degree)/float64(len(degrees)))))
+	if target.LessThan(c.root) {
+		return c.Transpose(target.Subtract(c.root).Reciprocal()), nil
+	}
+	return c.Transpose(target.Subtract(c.root)), nil
+}
+
+// Name identifies the chord from its notes above the root, first as a just chord and then by the nearest pattern of
+// twelve-tone equal temperament, or returns an empty string.
+func (c JustChord) Name() string {
+	var classes []JustInterval
+	for _, note := range c.notes {
+		interval := note.Subtract(c.root)
+		if note.LessThan(c.root) {
+			interval = interval.Reciprocal()
+		}
+		classes = appendIfMissing(classes, interval.OctaveReduce().Simplify())
+	}
+	SortIntervals(classes)
+
+	for _, chord := range justChordNames {
+		if slices.EqualFunc(classes, chord.classes, JustInterval.IsEqualTo) {
+			return chord.name
+		}
+	}
+	var cents []float64
+	for _, class := range classes {
+		cents = append(cents, class.ToCents())
+	}
+	return temperedChordName(cents)
+}
+
+func (c JustChord) String() string {
+	var notes []string
+	for _, note := range c.notes {
+		notes = append(notes, note.String())
+	}
+	return strings.Join(notes, " ")
+}
+
+// TemperedChord notes as tempered intervals above a tonic, lowest first, with the note the chord is built on as its root.
+type TemperedChord struct {
+	root  TemperedInterval
+	notes []TemperedInterval
+}
+
+// NewTemperedChord the chord of the given notes above a tonic, taking the first as its root.
+func NewTemperedChord(notes ...TemperedInterval) TemperedChord {
+	chord := TemperedChord{notes: slices.Clone(notes)}
+	if len(notes) > 0 {
+		chord.root = notes[0]
+	}
+	slices.Sort(chord.notes)
+	return chord
+}
+
+// Chord the chord built on a degree of the scale from the given steps above it, wrapping into higher octaves past the
+// end of the scale.
+func (s TemperedScale) Chord(root int, steps ...int) (TemperedChord, error) {
+	degrees := withoutTemperedOctave(s.Intervals())
+	if len(degrees) == 0 {
+		return TemperedChord{}, fmt.Errorf("cannot build a chord from %s as it has no degrees", s.system)
+	}
+	var notes []TemperedInterval
+	for _, step := range steps {
+		degree := root + step
+		octaves := math.Floor(float64(degree) / float64(len(degrees)))
+		notes = append(notes, degrees[wrapDegree(degree, len(degrees))]*TemperedInterval(math.Pow(2, octaves)))
+	}
+	chord := NewTemperedChord(notes...)
+	chord.root = degrees[wrapDegree(root, len(degrees))] * TemperedInterval(math.Pow(2, math.Floor(float64(root)/float64(len(degrees)))))
+	return chord, nil
+}
+
+func (c TemperedChord) Root() TemperedInterval {
+	return c.root
+}
+
+func (c TemperedChord) Notes() []TemperedInterval {
+	return c.notes
+}
+
+// Intervals returns each note's interval above the bass.
+func (c TemperedChord) Intervals() []TemperedInterval {
+	var intervals []TemperedInterval
+	for _, note := range c.notes {
+		intervals = append(intervals, note/c.notes[0])
+	}
+	return intervals
+}
+
+func (c TemperedChord) Frequencies(tonic float64) []float64 {
+	var frequencies []float64
+	for _, note := range c.notes {
+		frequencies = append(frequencies, tonic*note.ToFloat())
+	}
+	return frequencies
+}
+
+// Invert moves the bass up by octaves to above the top note as many times as the inversion asked for.
+func (c TemperedChord) Invert(inversion int) TemperedChord {
+	if len(c.notes) == 0 {
+		return c
+	}
+	notes := slices.Clone(c.notes)
+	for range inversion {
+		bass := notes[0]
+		for bass <= notes[len(notes)-1] {
+			bass *= 2
+		}
+		notes = append(notes[1:], bass)
+	}
+	return TemperedChord{root: c.root, notes: notes}
+}
+
+func (c TemperedChord) Voice(voicing Voicing) TemperedChord {
+	if len(c.notes) == 0 {
+		return c
+	}
+	bass := c.notes[0]
+	notes := []TemperedInterval{bass}
+	for _, note := range c.notes[1:] {
+		reduced := bass * TemperedInterval(math.Pow(2, math.Mod(math.Log2((note/bass).ToFloat()), 1)))
+		if !slices.Contains(notes, reduced) {
+			notes = append(notes, reduced)
+		}
+	}
+	slices.Sort(notes)
+	if voicing == OpenVoicing {
+		for i := 1; i < len(notes); i += 2 {
+			notes[i] *= 2
+		}
+		slices.Sort(notes)
+	}
+	return TemperedChord{root: c.root, notes: notes}
+}
+
+// Transpose moves every note, and the root, up by the interval.
+func (c TemperedChord) Transpose(interval TemperedInterval) TemperedChord {
+	notes := make([]TemperedInterval, len(c.notes))
+	for i, note := range c.notes {
+		notes[i] = note * interval
+	}
+	return TemperedChord{root: c.root * interval, notes: notes}
+}
+
+// TransposeToDegree moves the chord unchanged so that its root falls on the given degree of the scale.
+func (c TemperedChord) TransposeToDegree(scale TemperedScale, degree int) (TemperedChord, error) {
+	degrees := withoutTemperedOctave(scale.Intervals())
+	if len(degrees) == 0 {
+		return TemperedChord{}, fmt.Errorf("cannot transpose to a degree of %s as it has no degrees", scale.system)
+	}
+	target := degrees[wrapDegree(degree, len(degrees))] * TemperedInterval(math.Pow(2, math.Floor(float64(degree)/float64(len(degrees)))))
+	return c.Transpose(target / c.root), nil
+}
+
+// Name identifies the chord by the nearest pattern of twelve-tone equal temperament above its root, or returns an
+// empty string.
+func (c TemperedChord) Name() string {
+	var cents []float64
+	for _, note := range c.notes {
+		cents = append(cents, 1200*math.Log2((note/c.root).ToFloat()))
+	}
+	return temperedChordName(cents)
+}
+
+func (c TemperedChord) String() string {
+	var notes []string
+	for _, note := range c.notes {
+		notes = append(notes, formatCents(note.ToCents()))
+	}
+	return strings.Join(notes, " ")
+}
+
+var justChordNames = []struct {
+	name    string
+	classes []JustInterval
+}{
+	{"just major triad", IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}})},
+	{"just minor triad", IntervalsFromIntegers([][]uint{{1, 1}, {6, 5}, {3, 2}})},
+	{"Pythagorean major triad", IntervalsFromIntegers([][]uint{{1, 1}, {81, 64}, {3, 2}})},
+	{"Pythagorean minor triad", IntervalsFromIntegers([][]uint{{1, 1}, {32, 27}, {3, 2}})},
+	{"septimal minor triad", IntervalsFromIntegers([][]uint{{1, 1}, {7, 6}, {3, 2}})},
+	{"septimal diminished triad", IntervalsFromIntegers([][]uint{{1, 1}, {6, 5}, {7, 5}})},
+	{"just augmented triad", IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {25, 16}})},
+	{"harmonic seventh", IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}, {7, 4}})},
+	{"subharmonic seventh", IntervalsFromIntegers([][]uint{{1, 1}, {7, 6}, {7, 5}, {7, 4}})},
+	{"just dominant seventh", IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}, {9, 5}})},
+	{"Pythagorean dominant seventh", IntervalsFromIntegers([][]uint{{1, 1}, {81, 64}, {3, 2}, {16, 9}})},
+	{"just major seventh", IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}, {15, 8}})},
+	{"just minor seventh", IntervalsFromIntegers([][]uint{{1, 1}, {6, 5}, {3, 2}, {9, 5}})},
+	{"harmonic ninth", IntervalsFromIntegers([][]uint{{1, 1}, {9, 8}, {5, 4}, {3, 2}, {7, 4}})},
+	{"harmonic eleventh", IntervalsFromIntegers([][]uint{{1, 1}, {9, 8}, {5, 4}, {11, 8}, {3, 2}, {7, 4}})},
+}
+
+var temperedChordNames = map[string]string{
+	"0 4 7":        "major triad",
+	"0 3 7":        "minor triad",
+	"0 3 6":        "diminished triad",
+	"0 4 8":        "augmented triad",
+	"0 2 7":        "suspended second",
+	"0 5 7":        "suspended fourth",
+	"0 4 7 10":     "dominant seventh",
+	"0 4 7 11":     "major seventh",
+	"0 3 7 10":     "minor seventh",
+	"0 3 7 11":     "minor major seventh",
+	"0 3 6 10":     "half-diminished seventh",
+	"0 3 6 9":      "diminished seventh",
+	"0 4 8 11":     "augmented major seventh",
+	"0 2 4 7 10":   "dominant ninth",
+	"0 2 4 7 11":   "major ninth",
+	"0 2 3 7 10":   "minor ninth",
+	"0 2 4 5 7 10": "dominant eleventh",
+}
+
+// temperedChordName names the chord whose notes lie the given cents above its root after rounding each to the nearest
+// semitone and reducing into the octave.
+func temperedChordName(cents []float64) string {
+	var semitones []int
+	for _, c := range cents {
+		semitone := wrapDegree(int(math.Round(c/100)), 12)
+		if !slices.Contains(semitones, semitone) {
+			semitones = append(semitones, semitone)
+		}
+	}
+	slices.Sort(semitones)
+	var pattern []string
+	for _, semitone := range semitones {
+		pattern = append(pattern, fmt.Sprint(semitone))
+	}
+	return temperedChordNames[strings.Join(pattern, " ")]
+}
+
+func shiftOctaves(interval JustInterval, octaves int) JustInterval {
+	for ; octaves > 0; octaves-- {
+		interval = interval.Add(Octave())
+	}
+	for ; octaves < 0; octaves++ {
+		interval = interval.Add(Octave().Reciprocal())
+	}
+	return interval
+}
@@ -0,0 +1,168 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ChordOrientation whether a chord is more simply heard as part of a harmonic series or a subharmonic series.
+type ChordOrientation string
+
+const (
+	Otonal    ChordOrientation = "otonal"
+	Utonal    ChordOrientation = "utonal"
+	Ambitonal ChordOrientation = "ambitonal"
+)
+
+func (o ChordOrientation) String() string {
+	return string(o)
+}
+
+// ParseChordRatios reads extended ratio notation, either otonal as in 4:5:6:7 or utonal as in 1/(6:5:4), into a chord
+// whose first term is the unison.
+func ParseChordRatios(notation string) (JustChord, error) {
+	trimmed := strings.ReplaceAll(notation, " ", "")
+	utonal := strings.HasPrefix(trimmed, "1/(") && strings.HasSuffix(trimmed, ")")
+	if utonal {
+		trimmed = strings.TrimSuffix(strings.TrimPrefix(trimmed, "1/("), ")")
+	}
+
+	var terms []uint
+	for _, term := range strings.Split(trimmed, ":") {
+		value, err := strconv.ParseUint(term, 10, 0)
+		if err != nil || value == 0 {
+			return JustChord{}, fmt.Errorf("%q is not a chord in ratio notation: %q is not a positive whole number", notation, term)
+		}
+		terms = append(terms, uint(value))
+	}
+	if len(terms) < 2 {
+		return JustChord{}, fmt.Errorf("%q is not a chord in ratio notation: it needs at least two terms", notation)
+	}
+
+	if utonal {
+		return NewUtonalChord(terms...), nil
+	}
+	return NewOtonalChord(terms...), nil
+}
+
+// OtonalForm the chord's notes as the smallest whole-numbered harmonics of a common fundamental, lowest first.
+func (c JustChord) OtonalForm() []uint {
+	return smallestWholeNumbersOf(c.notes)
+}
+
+// UtonalForm the chord's notes as the smallest whole-numbered subharmonics of a common overtone, lowest first.
+func (c JustChord) UtonalForm() []uint {
+	var reciprocals []JustInterval
+	for _, note := range c.notes {
+		reciprocals = append(reciprocals, note.Reciprocal())
+	}
+	return smallestWholeNumbersOf(reciprocals)
+}
+
+func (c JustChord) OtonalNotation() string {
+	return joinRatioTerms(c.OtonalForm())
+}
+
+func (c JustChord) UtonalNotation() string {
+	return "1/(" + joinRatioTerms(c.UtonalForm()) + ")"
+}
+
+// Notation writes the chord in whichever of otonal or utonal ratio notation has the smaller terms, preferring otonal.
+func (c JustChord) Notation() string {
+	if c.Orientation() == Utonal {
+		return c.UtonalNotation()
+	}
+	return c.OtonalNotation()
+}
+
+// VirtualFundamental the common fundamental of which every note is a harmonic, as an interval above the tonic; it is
+// usually below the tonic.
+func (c JustChord) VirtualFundamental() JustInterval {
+	if len(c.notes) == 0 {
+		return Unison()
+	}
+	bass := c.notes[0].Simplify()
+	return JustInterval{numerator: bass.numerator, denominator: bass.denominator * c.OtonalForm()[0]}.Simplify()
+}
+
+func (c JustChord) VirtualFundamentalFrequency(tonic float64) float64 {
+	return tonic * c.VirtualFundamental().ToFloat()
+}
+
+// OtonalComplexity the highest harmonic in the chord's otonal form.
+func (c JustChord) OtonalComplexity() uint {
+	return slices.Max(append(c.OtonalForm(), 1))
+}
+
+// UtonalComplexity the highest subharmonic in the chord's utonal form.
+func (c JustChord) UtonalComplexity() uint {
+	return slices.Max(append(c.UtonalForm(), 1))
+}
+
+// Complexity the smaller of the chord's otonal and utonal complexities.
+func (c JustChord) Complexity() uint {
+	return min(c.OtonalComplexity(), c.UtonalComplexity())
+}
+
+func (c JustChord) Orientation() ChordOrientation {
+	otonal, utonal := c.OtonalComplexity(), c.UtonalComplexity()
+	switch {
+	case otonal < utonal:
+		return Otonal
+	case utonal < otonal:
+		return Utonal
+	default:
+		return Ambitonal
+	}
+}
+
+// RankChords orders chords from least to most complex, keeping the given order among equals.
+func RankChords(chords []JustChord) []JustChord {
+	ranked := slices.Clone(chords)
+	slices.SortStableFunc(ranked, func(a, b JustChord) int {
+		return int(a.Complexity()) - int(b.Complexity())
+	})
+	return ranked
+}
+
+// smallestWholeNumbersOf scales the ratios by the least common multiple of their denominators and divides out any
+// common factor, so 1/1, 5/4 and 3/2 become 4, 5 and 6.
+func smallestWholeNumbersOf(ratios []JustInterval) []uint {
+	multiple := uint(1)
+	for _, ratio := range ratios {
+		multiple = leastCommonMultipleOf(multiple, ratio.Simplify().denominator)
+	}
+	var terms []uint
+	divisor := uint(0)
+	for _, ratio := range ratios {
+		simplified := ratio.Simplify()
+		term := simplified.numerator * (multiple / simplified.denominator)
+		terms = append(terms, term)
+		divisor = greatestCommonDivisorOf(divisor, term)
+	}
+	for i := range terms {
+		terms[i] /= max(divisor, 1)
+	}
+	return terms
+}
+
+func joinRatioTerms(terms []uint) string {
+	var parts []string
+	for _, term := range terms {
+		parts = append(parts, strconv.FormatUint(uint64(term), 10))
+	}
+	return strings.Join(parts, ":")
+}
+
+func greatestCommonDivisorOf(a, b uint) uint {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func leastCommonMultipleOf(a, b uint) uint {
+	return a / greatestCommonDivisorOf(a, b) * b
+}
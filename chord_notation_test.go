@@ -0,0 +1,92 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldParseOtonalChordRatios(t *testing.T) {
+	// When
+	chord, err := ParseChordRatios("4:5:6:7")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}, {7, 4}}), chord.Notes())
+	assert.Equal(t, "harmonic seventh", chord.Name())
+	assert.Equal(t, "4:5:6:7", chord.Notation())
+}
+
+func Test_ShouldParseUtonalChordRatios(t *testing.T) {
+	// When
+	chord, err := ParseChordRatios("1/(6 : 5 : 4)")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {6, 5}, {3, 2}}), chord.Notes())
+	assert.Equal(t, "10:12:15", chord.OtonalNotation())
+	assert.Equal(t, "1/(6:5:4)", chord.UtonalNotation())
+	assert.Equal(t, "1/(6:5:4)", chord.Notation())
+	assert.Equal(t, Utonal, chord.Orientation())
+}
+
+func Test_ShouldReturnErrorForMalformedChordRatios(t *testing.T) {
+	tests := []struct {
+		notation string
+		error    string
+	}{
+		{"4:five:6", `"4:five:6" is not a chord in ratio notation: "five" is not a positive whole number`},
+		{"4:0:6", `"4:0:6" is not a chord in ratio notation: "0" is not a positive whole number`},
+		{"7", `"7" is not a chord in ratio notation: it needs at least two terms`},
+		{"1/(6:5", `"1/(6:5" is not a chord in ratio notation: "1/(6" is not a positive whole number`},
+	}
+	for _, tt := range tests {
+		if _, err := ParseChordRatios(tt.notation); err == nil || err.Error() != tt.error {
+			t.Errorf("ParseChordRatios(%q) error = %v, want %v", tt.notation, err, tt.error)
+		}
+	}
+}
+
+func Test_ShouldReduceScaleChordToLowestHarmonicSeries(t *testing.T) {
+	// Given
+	supertonic, _ := NewIntenseDiatonicScale(IonianMode).Chord(1, Triad()...)
+
+	// When
+	otonal := supertonic.OtonalForm()
+
+	// Then
+	assert.Equal(t, []uint{27, 32, 40}, otonal)
+	assert.Equal(t, uint(40), supertonic.OtonalComplexity())
+	assert.Equal(t, uint(160), supertonic.UtonalComplexity())
+	assert.Equal(t, "1/(160:135:108)", supertonic.UtonalNotation())
+	assert.Equal(t, "27:32:40", supertonic.Notation())
+}
+
+func Test_ShouldReturnVirtualFundamentalOfChord(t *testing.T) {
+	// Given
+	dominant, _ := NewIntenseDiatonicScale(IonianMode).Chord(4, Triad()...)
+
+	// When
+	fundamental := dominant.VirtualFundamental()
+
+	// Then
+	assert.Equal(t, NewInterval(3, 8), fundamental)
+	assert.Equal(t, 99.0, dominant.VirtualFundamentalFrequency(264))
+}
+
+func Test_ShouldClassifyAndRankChordsByComplexity(t *testing.T) {
+	// Given
+	harmonicSeventh, _ := ParseChordRatios("4:5:6:7")
+	minor, _ := ParseChordRatios("10:12:15")
+	major, _ := ParseChordRatios("4:5:6")
+	ambitonal, _ := ParseChordRatios("2:3")
+
+	// When
+	ranked := RankChords([]JustChord{harmonicSeventh, major, minor, ambitonal})
+
+	// Then
+	assert.Equal(t, []JustChord{ambitonal, major, minor, harmonicSeventh}, ranked)
+	assert.Equal(t, Ambitonal, ambitonal.Orientation())
+	assert.Equal(t, Otonal, major.Orientation())
+	assert.Equal(t, uint(6), minor.Complexity())
+}
@@ -0,0 +1,178 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldBuildTriadsOnDegreesOfIntenseDiatonicScale(t *testing.T) {
+	// Given
+	scale := NewIntenseDiatonicScale(IonianMode)
+
+	// When
+	tonic, _ := scale.Chord(0, Triad()...)
+	supertonic, _ := scale.Chord(1, Triad()...)
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}}), tonic.Notes())
+	assert.Equal(t, "just major triad", tonic.Name())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{9, 8}, {4, 3}, {5, 3}}), supertonic.Notes())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {32, 27}, {40, 27}}), supertonic.Intervals())
+	assert.Equal(t, "minor triad", supertonic.Name())
+}
+
+func Test_ShouldWrapChordDegreesIntoHigherOctaves(t *testing.T) {
+	// Given
+	scale := NewIntenseDiatonicScale(IonianMode)
+
+	// When
+	submediant, _ := scale.Chord(5, Seventh()...)
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{5, 3}, {2, 1}, {5, 2}, {3, 1}}), submediant.Notes())
+	assert.Equal(t, NewInterval(5, 3), submediant.Root())
+	assert.Equal(t, "just minor seventh", submediant.Name())
+}
+
+func Test_ShouldNameOtonalAndUtonalChords(t *testing.T) {
+	// When
+	harmonicSeventh := NewOtonalChord(4, 5, 6, 7)
+	minor := NewUtonalChord(6, 5, 4)
+
+	// Then
+	assert.Equal(t, "harmonic seventh", harmonicSeventh.Name())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}, {7, 4}}), harmonicSeventh.Intervals())
+	assert.Equal(t, "just minor triad", minor.Name())
+	assert.Equal(t, "harmonic eleventh", NewOtonalChord(8, 9, 10, 11, 12, 14).Name())
+}
+
+func Test_ShouldNamePythagoreanDominantSeventhOnlyWithPythagoreanThird(t *testing.T) {
+	// When
+	pythagorean := NewJustChord(IntervalsFromIntegers([][]uint{{1, 1}, {81, 64}, {3, 2}, {16, 9}})...)
+	fiveLimit := NewOtonalChord(36, 45, 54, 64)
+
+	// Then
+	assert.Equal(t, "Pythagorean dominant seventh", pythagorean.Name())
+	assert.NotEqual(t, "Pythagorean dominant seventh", fiveLimit.Name())
+}
+
+func Test_ShouldInvertChordWiderThanAnOctaveKeepingNotesInOrder(t *testing.T) {
+	// When
+	just := NewOtonalChord(4, 5, 6, 7, 9).Invert(1)
+	tempered := TemperedChord{notes: []TemperedInterval{1, 1.25, 1.5, 1.75, 2.25}}.Invert(1)
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{5, 4}, {3, 2}, {7, 4}, {9, 4}, {4, 1}}), just.Notes())
+	assert.Equal(t, []TemperedInterval{1.25, 1.5, 1.75, 2.25, 4}, tempered.Notes())
+}
+
+func Test_ShouldLeaveChordWithoutNotesUnchangedWhenInverted(t *testing.T) {
+	assert.Empty(t, NewJustChord().Invert(1).Notes())
+	assert.Empty(t, TemperedChord{}.Invert(2).Notes())
+}
+
+func Test_ShouldInvertChordKeepingItsRoot(t *testing.T) {
+	// Given
+	chord := NewOtonalChord(4, 5, 6)
+
+	// When
+	first := chord.Invert(1)
+	second := chord.Invert(2)
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{5, 4}, {3, 2}, {2, 1}}), first.Notes())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {6, 5}, {8, 5}}), first.Intervals())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {4, 3}, {5, 3}}), second.Intervals())
+	assert.Equal(t, "just major triad", second.Name())
+	assert.Equal(t, Unison(), second.Root())
+}
+
+func Test_ShouldVoiceChordClosedAndOpen(t *testing.T) {
+	// Given
+	chord := NewJustChord(Unison(), NewInterval(3, 1), NewInterval(5, 2), NewInterval(7, 4))
+
+	// When
+	closed := chord.Voice(ClosedVoicing)
+	open := chord.Voice(OpenVoicing)
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {5, 4}, {3, 2}, {7, 4}}), closed.Notes())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {3, 2}, {5, 2}, {7, 2}}), open.Notes())
+	assert.Equal(t, "harmonic seventh", open.Name())
+}
+
+func Test_ShouldTransposeChordByIntervalAndToDegree(t *testing.T) {
+	// Given
+	scale := NewIntenseDiatonicScale(IonianMode)
+	chord := NewOtonalChord(4, 5, 6, 7)
+
+	// When
+	up := chord.Transpose(PerfectFourth())
+	dominant, err := chord.TransposeToDegree(scale, 4)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{4, 3}, {5, 3}, {2, 1}, {7, 3}}), up.Notes())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{3, 2}, {15, 8}, {9, 4}, {21, 8}}), dominant.Notes())
+	assert.Equal(t, PerfectFifth(), dominant.Root())
+}
+
+func Test_ShouldReturnFrequenciesOfChord(t *testing.T) {
+	// When
+	frequencies := NewOtonalChord(4, 5, 6).Frequencies(200)
+
+	// Then
+	assert.Equal(t, []float64{200, 250, 300}, frequencies)
+}
+
+func Test_ShouldReturnErrorWhenScaleHasNoDegrees(t *testing.T) {
+	// Given
+	scale := JustScale{system: "Empty", algorithm: func() []JustInterval { return nil }}
+
+	// When
+	_, err := scale.Chord(0, Triad()...)
+
+	// Then
+	assert.EqualError(t, err, "cannot build a chord from Empty as it has no degrees")
+}
+
+func Test_ShouldBuildAndNameTemperedChords(t *testing.T) {
+	// Given
+	scale := NewEqualTemperamentScale(12)
+
+	// When
+	dominantSeventh, _ := scale.Chord(7, 0, 4, 7, 10)
+	minor, _ := scale.Chord(9, 0, 3, 7)
+
+	// Then
+	assert.Equal(t, "dominant seventh", dominantSeventh.Name())
+	assert.Equal(t, []float64{700, 1100, 1400, 1700}, centsOfTemperedNotes(dominantSeventh.Notes()))
+	assert.Equal(t, "minor triad", minor.Name())
+	assert.Equal(t, "minor triad", minor.Invert(1).Name())
+}
+
+func Test_ShouldVoiceAndTransposeTemperedChords(t *testing.T) {
+	// Given
+	scale := NewBachWohltemperierteKlavierScale()
+	chord, _ := scale.Chord(0, 0, 4, 7, 12)
+
+	// When
+	closed := chord.Voice(ClosedVoicing)
+	open := chord.Voice(OpenVoicing)
+	dominant, _ := closed.TransposeToDegree(scale, 7)
+
+	// Then
+	assert.Equal(t, []float64{0, 402.85, 701.96}, centsOfTemperedNotes(closed.Notes()))
+	assert.Equal(t, []float64{0, 701.96, 1602.85}, centsOfTemperedNotes(open.Notes()))
+	assert.Equal(t, []float64{701.96, 1104.81, 1403.91}, centsOfTemperedNotes(dominant.Notes()))
+	assert.Equal(t, "major triad", dominant.Name())
+}
+
+func centsOfTemperedNotes(notes []TemperedInterval) []float64 {
+	var cents []float64
+	for _, note := range notes {
+		cents = append(cents, note.ToCents())
+	}
+	return cents
+}
@@ -0,0 +1,194 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+)
+
+// CombinationProductSet Erv Wilson's combination product set: every product of the factors taken choose at a time.
+// Reference: https://www.anaphoria.com/wilsoncps.html
+type CombinationProductSet struct {
+	factors  []uint
+	choose   int
+	products []CombinationProduct
+}
+
+type CombinationProduct struct {
+	factors []uint
+	product uint
+}
+
+// CombinationProductConnection joins two products that share all but one factor; the interval between them is the
+// ratio of the two factors that differ.
+type CombinationProductConnection struct {
+	From     int
+	To       int
+	Interval JustInterval
+}
+
+// NewCombinationProductSet fails unless the factors are distinct and at least 1 and choose is between 1 and the
+// number of factors.
+func NewCombinationProductSet(factors []uint, choose int) (CombinationProductSet, error) {
+	for i, factor := range factors {
+		if factor == 0 {
+			return CombinationProductSet{}, fmt.Errorf("combination product set factors must be at least 1 but got %v", factors)
+		}
+		if slices.Contains(factors[:i], factor) {
+			return CombinationProductSet{}, fmt.Errorf("combination product set factors must be distinct but %d appears more than once", factor)
+		}
+	}
+	if choose < 1 || choose > len(factors) {
+		return CombinationProductSet{}, fmt.Errorf("cannot choose %d of %d factors for a combination product set", choose, len(factors))
+	}
+	var products []CombinationProduct
+	for _, combination := range combinationsOf(len(factors), choose) {
+		product := CombinationProduct{product: 1}
+		for _, i := range combination {
+			product.factors = append(product.factors, factors[i])
+			product.product *= factors[i]
+		}
+		products = append(products, product)
+	}
+	return CombinationProductSet{factors: slices.Clone(factors), choose: choose, products: products}, nil
+}
+
+func NewHexany(factors ...uint) (CombinationProductSet, error) {
+	return NewCombinationProductSet(factors, 2)
+}
+
+func NewDekany(factors ...uint) (CombinationProductSet, error) {
+	return NewCombinationProductSet(factors, 2)
+}
+
+func NewEikosany(factors ...uint) (CombinationProductSet, error) {
+	return NewCombinationProductSet(factors, 3)
+}
+
+func (p CombinationProduct) Factors() []uint {
+	return p.factors
+}
+
+func (p CombinationProduct) Product() uint {
+	return p.product
+}
+
+func (p CombinationProduct) String() string {
+	s := ""
+	for i, factor := range p.factors {
+		if i > 0 {
+			s += "·"
+		}
+		s += fmt.Sprintf("%d", factor)
+	}
+	return s
+}
+
+func (c CombinationProductSet) Factors() []uint {
+	return c.factors
+}
+
+func (c CombinationProductSet) Choose() int {
+	return c.choose
+}
+
+func (c CombinationProductSet) Products() []CombinationProduct {
+	return c.products
+}
+
+// Connections returns the edges of the combination product set's lattice, between products at indices From and To
+// of Products().
+func (c CombinationProductSet) Connections() []CombinationProductConnection {
+	var connections []CombinationProductConnection
+	for i := 0; i < len(c.products); i++ {
+		for j := i + 1; j < len(c.products); j++ {
+			from, to, shared := differingFactors(c.products[i].factors, c.products[j].factors)
+			if shared != c.choose-1 {
+				continue
+			}
+			connections = append(connections, CombinationProductConnection{From: i, To: j, Interval: NewInterval(to, from)})
+		}
+	}
+	return connections
+}
+
+// Intervals returns the octave-reduced products normalised so that the product at index degree of Products() is 1/1.
+func (c CombinationProductSet) Intervals(degree int) ([]JustInterval, error) {
+	if err := c.checkDegree(degree); err != nil {
+		return nil, err
+	}
+	var intervals []JustInterval
+	tonic := c.products[degree].product
+	for _, product := range c.products {
+		intervals = appendIfMissing(intervals, JustInterval{numerator: product.product, denominator: tonic}.OctaveReduce().Simplify())
+	}
+	SortIntervals(intervals)
+	return append(intervals, Octave()), nil
+}
+
+func (c CombinationProductSet) Scale(degree int) (JustScale, error) {
+	if err := c.checkDegree(degree); err != nil {
+		return JustScale{}, err
+	}
+	return JustScale{
+		system:      "Combination Product Set",
+		description: fmt.Sprintf("Wilson %d)%d combination product set of %v normalised to %s.", c.choose, len(c.factors), c.factors, c.products[degree]),
+		algorithm: func() []JustInterval {
+			intervals, _ := c.Intervals(degree)
+			return intervals
+		},
+	}, nil
+}
+
+func (c CombinationProductSet) checkDegree(degree int) error {
+	if degree < 0 || degree >= len(c.products) {
+		return fmt.Errorf("combination product set has no product %d as it has %d products", degree, len(c.products))
+	}
+	return nil
+}
+
+func differingFactors(a, b []uint) (uint, uint, int) {
+	remaining := append([]uint{}, b...)
+	var from uint = 1
+	shared := 0
+	for _, factor := range a {
+		found := false
+		for i, candidate := range remaining {
+			if candidate == factor {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if found {
+			shared++
+		} else {
+			from *= factor
+		}
+	}
+	var to uint = 1
+	for _, factor := range remaining {
+		to *= factor
+	}
+	return from, to, shared
+}
+
+func combinationsOf(n, k int) [][]int {
+	if k <= 0 || k > n {
+		return nil
+	}
+	var combinations [][]int
+	combination := make([]int, k)
+	var build func(start, depth int)
+	build = func(start, depth int) {
+		if depth == k {
+			combinations = append(combinations, append([]int{}, combination...))
+			return
+		}
+		for i := start; i <= n-(k-depth); i++ {
+			combination[depth] = i
+			build(i+1, depth+1)
+		}
+	}
+	build(0, 0)
+	return combinations
+}
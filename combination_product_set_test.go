@@ -0,0 +1,100 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldReturnHexanyOfFirstFourOddNumbers(t *testing.T) {
+	// Given
+	hexany, _ := NewHexany(1, 3, 5, 7)
+
+	// When
+	scale, err := hexany.Scale(0)
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Combination Product Set", scale.System())
+	assert.Equal(t, "Wilson 2)4 combination product set of [1 3 5 7] normalised to 1·3.", scale.Description())
+	assert.Equal(t, 7, len(intervals))
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, intervals[0])
+	assert.Equal(t, JustInterval{numerator: 7, denominator: 6}, intervals[1])
+	assert.Equal(t, JustInterval{numerator: 5, denominator: 4}, intervals[2])
+	assert.Equal(t, JustInterval{numerator: 35, denominator: 24}, intervals[3])
+	assert.Equal(t, JustInterval{numerator: 5, denominator: 3}, intervals[4])
+	assert.Equal(t, JustInterval{numerator: 7, denominator: 4}, intervals[5])
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[6])
+}
+
+func Test_ShouldReturnConnectionsOfHexanyLattice(t *testing.T) {
+	// Given
+	hexany, _ := NewHexany(1, 3, 5, 7)
+
+	// When
+	connections := hexany.Connections()
+
+	// Then
+	assert.Equal(t, 6, len(hexany.Products()))
+	assert.Equal(t, uint(3), hexany.Products()[0].Product())
+	assert.Equal(t, []uint{1, 3}, hexany.Products()[0].Factors())
+	assert.Equal(t, 12, len(connections))
+	assert.Equal(t, CombinationProductConnection{From: 0, To: 1, Interval: JustInterval{numerator: 5, denominator: 3}}, connections[0])
+	for _, connection := range connections {
+		assert.NotEqual(t, 5, connection.From+connection.To, "opposite products of a hexany are not connected")
+	}
+}
+
+func Test_ShouldReturnEikosanyWithTwentyProducts(t *testing.T) {
+	// Given
+	eikosany, _ := NewEikosany(1, 3, 5, 7, 9, 11)
+
+	// When
+	intervals, err := eikosany.Intervals(0)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 20, len(eikosany.Products()))
+	assert.Equal(t, 21, len(intervals))
+	assert.Equal(t, 90, len(eikosany.Connections()))
+	assert.True(t, slicesAreSorted(intervals))
+}
+
+func Test_ShouldRejectZeroOrDuplicateFactors(t *testing.T) {
+	// When
+	_, zero := NewHexany(0, 3, 5, 7)
+	_, duplicate := NewHexany(1, 3, 3, 7)
+
+	// Then
+	assert.EqualError(t, zero, "combination product set factors must be at least 1 but got [0 3 5 7]")
+	assert.EqualError(t, duplicate, "combination product set factors must be distinct but 3 appears more than once")
+}
+
+func Test_ShouldRejectChooseOutsideNumberOfFactors(t *testing.T) {
+	// When
+	_, tooMany := NewCombinationProductSet([]uint{1, 3, 5}, 4)
+	_, none := NewCombinationProductSet([]uint{1, 3, 5}, 0)
+
+	// Then
+	assert.EqualError(t, tooMany, "cannot choose 4 of 3 factors for a combination product set")
+	assert.EqualError(t, none, "cannot choose 0 of 3 factors for a combination product set")
+}
+
+func Test_ShouldRejectDegreeOutsideProducts(t *testing.T) {
+	// Given
+	hexany, _ := NewHexany(1, 3, 5, 7)
+
+	// When
+	_, scaleErr := hexany.Scale(6)
+	_, intervalsErr := hexany.Intervals(-1)
+
+	// Then
+	assert.EqualError(t, scaleErr, "combination product set has no product 6 as it has 6 products")
+	assert.EqualError(t, intervalsErr, "combination product set has no product -1 as it has 6 products")
+}
+
+func Test_combinationsOf(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {1, 2}}, combinationsOf(3, 2))
+	assert.Nil(t, combinationsOf(2, 3))
+}
@@ -0,0 +1,204 @@
+package music
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// DriftStrategy how a progression decides where to place each chord relative to the one before it.
+type DriftStrategy string
+
+const (
+	// FixedTonic plays every chord at its nominal ratios from the tonic, so nothing drifts but a common tone may move.
+	FixedTonic DriftStrategy = "fixed tonic"
+	// CommonTone shifts each chord so that a tone it shares with the chord before is held, letting the tonic drift.
+	CommonTone DriftStrategy = "common tone"
+	// MinimiseDrift holds whichever common tone brings the progression closest to its nominal ratios, returning to
+	// them when there is no common tone to hold.
+	MinimiseDrift DriftStrategy = "minimise drift"
+)
+
+func (s DriftStrategy) String() string {
+	return string(s)
+}
+
+// commonToneTolerance how far apart, in cents, two notes of consecutive chords may be and still count as a common tone.
+const commonToneTolerance = 50.0
+
+// Progression a sequence of chords, each given at its nominal just ratios from the tonic.
+type Progression struct {
+	chords []JustChord
+}
+
+func NewProgression(chords ...JustChord) Progression {
+	return Progression{chords: chords}
+}
+
+// PlacedChord a chord of a progression where a strategy placed it. Adjustment is how far it was moved from where the
+// chord before left it and Drift how far it now lies from its nominal ratios. HasCommonTone tells whether it shares a
+// tone with the chord before, and if so HeldToneShift is how far the nearest common tone moved, the unison when it was
+// held.
+type PlacedChord struct {
+	Chord         JustChord
+	Adjustment    JustInterval
+	Drift         JustInterval
+	HasCommonTone bool
+	HeldToneShift JustInterval
+}
+
+// DriftReport where each chord of a progression was placed by a strategy.
+type DriftReport struct {
+	Strategy DriftStrategy
+	Chords   []PlacedChord
+}
+
+// Drift the drift of the last chord from its nominal ratios, such as 80/81 after I–vi–ii–V–I in five-limit just
+// intonation with common tones held.
+func (r DriftReport) Drift() JustInterval {
+	if len(r.Chords) == 0 {
+		return Unison()
+	}
+	return r.Chords[len(r.Chords)-1].Drift
+}
+
+func (r DriftReport) DriftCents() float64 {
+	return r.Drift().ToCents()
+}
+
+// HeldToneShifts counts the chords that share a tone with the chord before but at which it was not held.
+func (r DriftReport) HeldToneShifts() int {
+	shifts := 0
+	for _, chord := range r.Chords {
+		if chord.HasCommonTone && !chord.HeldToneShift.IsUnison() {
+			shifts++
+		}
+	}
+	return shifts
+}
+
+func (r DriftReport) String() string {
+	return fmt.Sprintf("%s: drift %s (%s cents) with %d held tones shifted", r.Strategy, r.Drift(), formatCents(r.DriftCents()), r.HeldToneShifts())
+}
+
+// Simulate places each chord by the strategy, failing for an unknown strategy or when the drift grows too complex to
+// hold as a ratio, as it does after a long run of commas.
+func (p Progression) Simulate(strategy DriftStrategy) (DriftReport, error) {
+	switch strategy {
+	case FixedTonic, CommonTone, MinimiseDrift:
+	default:
+		return DriftReport{}, fmt.Errorf("%s is not a known drift strategy", strategy)
+	}
+
+	report := DriftReport{Strategy: strategy}
+	drift := Unison()
+	for i, nominal := range p.chords {
+		tooComplex := fmt.Errorf("drift at chord %d is too complex to hold as a ratio", i+1)
+		adjustment := Unison()
+		if i > 0 {
+			current, ok := transposeWithoutOverflow(nominal, drift)
+			if !ok {
+				return DriftReport{}, tooComplex
+			}
+			adjustment = adjustmentFor(strategy, report.Chords[i-1].Chord, current, drift)
+		}
+		var ok bool
+		if drift, ok = addWithoutOverflow(drift, adjustment); !ok {
+			return DriftReport{}, tooComplex
+		}
+		placed, ok := transposeWithoutOverflow(nominal, drift)
+		if !ok {
+			return DriftReport{}, tooComplex
+		}
+
+		chord := PlacedChord{Chord: placed, Adjustment: adjustment, Drift: drift, HeldToneShift: Unison()}
+		if i > 0 {
+			if holds := commonToneAdjustments(report.Chords[i-1].Chord, placed); len(holds) > 0 {
+				chord.HasCommonTone = true
+				chord.HeldToneShift = holds[0].Reciprocal().Simplify()
+			}
+		}
+		report.Chords = append(report.Chords, chord)
+	}
+	return report, nil
+}
+
+func adjustmentFor(strategy DriftStrategy, previous, current JustChord, drift JustInterval) JustInterval {
+	holds := commonToneAdjustments(previous, current)
+	switch {
+	case strategy == FixedTonic, strategy == MinimiseDrift && len(holds) == 0:
+		return drift.Reciprocal().Simplify()
+	case strategy == MinimiseDrift:
+		best := holds[0]
+		for _, hold := range holds[1:] {
+			if math.Abs(drift.Add(hold).ToCents()) < math.Abs(drift.Add(best).ToCents()) {
+				best = hold
+			}
+		}
+		return best
+	case strategy == CommonTone && len(holds) > 0:
+		return holds[0]
+	}
+	return Unison()
+}
+
+// transposeWithoutOverflow transposes the chord, reporting false if any of its notes would not fit.
+func transposeWithoutOverflow(chord JustChord, interval JustInterval) (JustChord, bool) {
+	root, ok := addWithoutOverflow(chord.root, interval)
+	if !ok {
+		return JustChord{}, false
+	}
+	transposed := JustChord{root: root, notes: make([]JustInterval, len(chord.notes))}
+	for i, note := range chord.notes {
+		if transposed.notes[i], ok = addWithoutOverflow(note, interval); !ok {
+			return JustChord{}, false
+		}
+	}
+	return transposed, true
+}
+
+// addWithoutOverflow adds two intervals, cancelling common factors before multiplying, and reports false if the sum
+// does not fit.
+func addWithoutOverflow(a, b JustInterval) (JustInterval, bool) {
+	first := greatestCommonDivisorOf(a.numerator, b.denominator)
+	second := greatestCommonDivisorOf(b.numerator, a.denominator)
+	numeratorHigh, numerator := bits.Mul(a.numerator/first, b.numerator/second)
+	denominatorHigh, denominator := bits.Mul(a.denominator/second, b.denominator/first)
+	if numeratorHigh != 0 || denominatorHigh != 0 {
+		return JustInterval{}, false
+	}
+	return NewInterval(numerator, denominator), true
+}
+
+// commonToneAdjustments every interval by which the current chord could move to share a note, in any octave, with the
+// previous chord within the common-tone tolerance, smallest first.
+func commonToneAdjustments(previous, current JustChord) []JustInterval {
+	var adjustments []JustInterval
+	for _, held := range previous.notes {
+		for _, note := range current.notes {
+			difference, ok := addWithoutOverflow(held, note.Reciprocal())
+			if !ok {
+				continue
+			}
+			adjustment := nearestOctaveOf(difference)
+			if math.Abs(adjustment.ToCents()) <= commonToneTolerance {
+				adjustments = appendIfMissing(adjustments, adjustment)
+			}
+		}
+	}
+	slices.SortStableFunc(adjustments, func(a, b JustInterval) int {
+		return cmp.Compare(math.Abs(a.ToCents()), math.Abs(b.ToCents()))
+	})
+	return adjustments
+}
+
+// nearestOctaveOf moves the ratio by octaves to lie within half an octave of the unison.
+func nearestOctaveOf(interval JustInterval) JustInterval {
+	reduced := interval.OctaveReduce().Simplify()
+	if reduced.ToCents() > centsInOctave/2 {
+		return reduced.Add(Octave().Reciprocal())
+	}
+	return reduced
+}
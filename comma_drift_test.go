@@ -0,0 +1,124 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fiveLimitCadence() Progression {
+	major := NewOtonalChord(4, 5, 6)
+	minor, _ := ParseChordRatios("10:12:15")
+	return NewProgression(
+		major,
+		minor.Transpose(NewInterval(5, 3)),
+		minor.Transpose(NewInterval(10, 9)),
+		major.Transpose(PerfectFifth()),
+		major,
+	)
+}
+
+func Test_ShouldDriftBySyntonicCommaHoldingCommonTonesThroughCadence(t *testing.T) {
+	// When
+	report, _ := fiveLimitCadence().Simulate(CommonTone)
+
+	// Then
+	assert.Equal(t, NewInterval(80, 81), report.Drift())
+	assert.InDelta(t, -21.51, report.DriftCents(), 0.01)
+	assert.Equal(t, 0, report.HeldToneShifts())
+	assert.Equal(t, NewInterval(80, 81), report.Chords[3].Adjustment)
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{40, 27}, {50, 27}, {20, 9}}), report.Chords[3].Chord.Notes())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{80, 81}, {100, 81}, {40, 27}}), report.Chords[4].Chord.Notes())
+}
+
+func Test_ShouldNotDriftWithFixedTonicButShiftHeldTone(t *testing.T) {
+	// When
+	report, _ := fiveLimitCadence().Simulate(FixedTonic)
+
+	// Then
+	assert.Equal(t, Unison(), report.Drift())
+	assert.Equal(t, 1, report.HeldToneShifts())
+	assert.Equal(t, SyntonicComma(), report.Chords[3].HeldToneShift)
+	assert.Equal(t, "fixed tonic: drift 1:1 (0.00 cents) with 1 held tones shifted", report.String())
+}
+
+func Test_ShouldHoldTheCommonToneThatReturnsTowardsTonicWhenMinimisingDrift(t *testing.T) {
+	// Given
+	chords := fiveLimitCadence().chords[:4]
+	progression := NewProgression(append(chords, NewJustChord(PerfectFifth(), NewInterval(20, 9)), NewOtonalChord(4, 5, 6))...)
+
+	// When
+	commonTone, _ := progression.Simulate(CommonTone)
+	minimised, _ := progression.Simulate(MinimiseDrift)
+
+	// Then
+	assert.Equal(t, NewInterval(80, 81), commonTone.Drift())
+	assert.Equal(t, Unison(), commonTone.Chords[4].Adjustment)
+	assert.Equal(t, Unison(), minimised.Drift())
+	assert.Equal(t, SyntonicComma(), minimised.Chords[4].Adjustment)
+	assert.Equal(t, 0, minimised.HeldToneShifts())
+}
+
+func Test_ShouldReturnToNominalRatiosWhenMinimisingDriftWithoutCommonTone(t *testing.T) {
+	// Given
+	chords := fiveLimitCadence().chords[:4]
+	progression := NewProgression(append(chords, NewOtonalChord(4, 5, 6).Transpose(NewInterval(16, 15)))...)
+
+	// When
+	report, _ := progression.Simulate(MinimiseDrift)
+
+	// Then
+	assert.Equal(t, NewInterval(80, 81), report.Chords[3].Drift)
+	assert.Equal(t, Unison(), report.Drift())
+}
+
+func Test_ShouldKeepDriftWhenChordsShareNoTone(t *testing.T) {
+	// Given
+	major := NewOtonalChord(4, 5, 6)
+	progression := NewProgression(major, major.Transpose(NewInterval(16, 15)))
+
+	// When
+	report, _ := progression.Simulate(CommonTone)
+
+	// Then
+	assert.Equal(t, Unison(), report.Drift())
+	assert.False(t, report.Chords[1].HasCommonTone)
+	assert.Equal(t, 0, report.HeldToneShifts())
+}
+
+func Test_ShouldCountOnlyShiftsOfCommonTones(t *testing.T) {
+	// Given
+	major := NewOtonalChord(4, 5, 6)
+	progression := NewProgression(major, major.Transpose(NewInterval(16, 15)), major.Transpose(NewInterval(4, 3)), major)
+
+	// When
+	report, _ := progression.Simulate(FixedTonic)
+
+	// Then
+	assert.False(t, report.Chords[1].HasCommonTone)
+	assert.True(t, report.Chords[2].HasCommonTone)
+	assert.True(t, report.Chords[3].HasCommonTone)
+	assert.Equal(t, 0, report.HeldToneShifts())
+}
+
+func Test_ShouldReturnErrorForUnknownDriftStrategy(t *testing.T) {
+	// When
+	_, err := fiveLimitCadence().Simulate(DriftStrategy("wander"))
+
+	// Then
+	assert.EqualError(t, err, "wander is not a known drift strategy")
+}
+
+func Test_ShouldReturnErrorWhenDriftOverflows(t *testing.T) {
+	// Given
+	var chords []JustChord
+	for range 30 {
+		chords = append(chords, fiveLimitCadence().chords[:4]...)
+	}
+
+	// When
+	_, err := NewProgression(chords...).Simulate(CommonTone)
+
+	// Then
+	assert.ErrorContains(t, err, "too complex to hold as a ratio")
+}
@@ -0,0 +1,162 @@
+package music
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// TenneyHeight log2(n·d) of the interval in lowest terms.
+// Reference: James Tenney, "A History of 'Consonance' and 'Dissonance'" (1988)
+func (i JustInterval) TenneyHeight() float64 {
+	return math.Log2(float64(i.BenedettiHeight()))
+}
+
+// BenedettiHeight the product n·d of the interval in lowest terms.
+func (i JustInterval) BenedettiHeight() uint {
+	simplified := i.Simplify()
+	return simplified.numerator * simplified.denominator
+}
+
+// WilsonComplexity Erv Wilson's measure: the sum of the prime factors of n·d, counted with repetition and ignoring
+// factors of two.
+func (i JustInterval) WilsonComplexity() uint {
+	var complexity uint
+	for prime, exponent := range primeFactorsOf(i.BenedettiHeight()) {
+		if prime != 2 {
+			complexity += prime * uint(exponent)
+		}
+	}
+	return complexity
+}
+
+// EulerGradus Euler's gradus suavitatis of n·d: one more than the sum of e·(p-1) over each prime power p^e dividing it.
+// Reference: Leonhard Euler, Tentamen novae theoriae musicae (1739)
+func (i JustInterval) EulerGradus() uint {
+	gradus := uint(1)
+	for prime, exponent := range primeFactorsOf(i.BenedettiHeight()) {
+		gradus += uint(exponent) * (prime - 1)
+	}
+	return gradus
+}
+
+// OddLimit the larger of the odd parts of the numerator and denominator.
+func (i JustInterval) OddLimit() uint {
+	simplified := i.Simplify()
+	return max(oddPartOf(simplified.numerator), oddPartOf(simplified.denominator))
+}
+
+// PrimeLimit the largest prime factor of the numerator or denominator, or 1 for the unison.
+func (i JustInterval) PrimeLimit() uint {
+	limit := uint(1)
+	for prime := range primeFactorsOf(i.BenedettiHeight()) {
+		limit = max(limit, prime)
+	}
+	return limit
+}
+
+func oddPartOf(n uint) uint {
+	for n > 0 && n%2 == 0 {
+		n /= 2
+	}
+	return n
+}
+
+// NamedIntervals returns every interval that has a name, in the order they are listed.
+func NamedIntervals() []JustInterval {
+	return slices.Clone(intervalNames)
+}
+
+// RankIntervals returns the intervals ordered from most to least consonant by a metric where a lower value is more
+// consonant, for example RankIntervals(NamedIntervals(), JustInterval.TenneyHeight).
+func RankIntervals[M cmp.Ordered](intervals []JustInterval, metric func(JustInterval) M) []JustInterval {
+	ranked := slices.Clone(intervals)
+	slices.SortStableFunc(ranked, func(a, b JustInterval) int {
+		return cmp.Compare(metric(a), metric(b))
+	})
+	return ranked
+}
+
+// Partial one component of a tone's spectrum as a multiple of its fundamental frequency.
+type Partial struct {
+	Multiple  float64
+	Amplitude float64
+}
+
+// HarmonicSpectrum the first n harmonics with amplitudes falling by 0.88 per partial.
+func HarmonicSpectrum(n int) []Partial {
+	var spectrum []Partial
+	for k := range n {
+		spectrum = append(spectrum, Partial{Multiple: float64(k + 1), Amplitude: math.Pow(0.88, float64(k))})
+	}
+	return spectrum
+}
+
+// RoughnessModel William Sethares' parameterisation of the Plomp-Levelt sensory dissonance curve, summed over every
+// pair of partials of tones sharing a spectrum and sounding at given intervals above a base frequency.
+// Reference: William Sethares, Tuning, Timbre, Spectrum, Scale (2nd ed., 2005), appendix E
+type RoughnessModel struct {
+	baseFrequency float64
+	spectrum      []Partial
+}
+
+func NewRoughnessModel(baseFrequency float64, spectrum []Partial) RoughnessModel {
+	return RoughnessModel{baseFrequency: baseFrequency, spectrum: spectrum}
+}
+
+func (m RoughnessModel) Dyad(interval JustInterval) float64 {
+	return m.Ratios(1, interval.ToFloat())
+}
+
+func (m RoughnessModel) TemperedDyad(interval TemperedInterval) float64 {
+	return m.Ratios(1, interval.ToFloat())
+}
+
+// Chord the roughness of tones at each interval above the base frequency; include the unison to sound the base itself.
+func (m RoughnessModel) Chord(intervals []JustInterval) float64 {
+	var ratios []float64
+	for _, interval := range intervals {
+		ratios = append(ratios, interval.ToFloat())
+	}
+	return m.Ratios(ratios...)
+}
+
+func (m RoughnessModel) TemperedChord(intervals []TemperedInterval) float64 {
+	var ratios []float64
+	for _, interval := range intervals {
+		ratios = append(ratios, interval.ToFloat())
+	}
+	return m.Ratios(ratios...)
+}
+
+// Ratios the roughness of tones at each frequency ratio above the base frequency.
+func (m RoughnessModel) Ratios(ratios ...float64) float64 {
+	var frequencies, amplitudes []float64
+	for _, ratio := range ratios {
+		for _, partial := range m.spectrum {
+			frequencies = append(frequencies, m.baseFrequency*ratio*partial.Multiple)
+			amplitudes = append(amplitudes, partial.Amplitude)
+		}
+	}
+
+	roughness := 0.0
+	for i := range frequencies {
+		for j := i + 1; j < len(frequencies); j++ {
+			roughness += min(amplitudes[i], amplitudes[j]) * plompLeveltCurve(frequencies[i], frequencies[j])
+		}
+	}
+	return roughness
+}
+
+func plompLeveltCurve(f1, f2 float64) float64 {
+	const (
+		dStar = 0.24
+		s1    = 0.0207
+		s2    = 18.96
+		b1    = 3.51
+		b2    = 5.75
+	)
+	s := dStar / (s1*min(f1, f2) + s2)
+	difference := math.Abs(f2 - f1)
+	return math.Exp(-b1*s*difference) - math.Exp(-b2*s*difference)
+}
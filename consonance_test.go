@@ -0,0 +1,122 @@
+package music
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJustInterval_Heights(t *testing.T) {
+	tests := []struct {
+		interval  JustInterval
+		tenney    float64
+		benedetti uint
+		wilson    uint
+		gradus    uint
+		oddLimit  uint
+		prime     uint
+	}{
+		{Unison(), 0, 1, 0, 1, 1, 1},
+		{Octave(), 1, 2, 0, 2, 1, 2},
+		{PerfectFifth(), math.Log2(6), 6, 3, 4, 3, 3},
+		{NewInterval(5, 4), math.Log2(20), 20, 5, 7, 5, 5},
+		{NewInterval(7, 4), math.Log2(28), 28, 7, 9, 7, 7},
+		{NewInterval(9, 8), math.Log2(72), 72, 6, 8, 9, 3},
+		{NewInterval(16, 15), math.Log2(240), 240, 8, 11, 15, 5},
+	}
+	for _, tt := range tests {
+		if got := tt.interval.TenneyHeight(); math.Abs(got-tt.tenney) > 1e-9 {
+			t.Errorf("TenneyHeight(%v) = %v, want %v", tt.interval, got, tt.tenney)
+		}
+		if got := tt.interval.BenedettiHeight(); got != tt.benedetti {
+			t.Errorf("BenedettiHeight(%v) = %v, want %v", tt.interval, got, tt.benedetti)
+		}
+		if got := tt.interval.WilsonComplexity(); got != tt.wilson {
+			t.Errorf("WilsonComplexity(%v) = %v, want %v", tt.interval, got, tt.wilson)
+		}
+		if got := tt.interval.EulerGradus(); got != tt.gradus {
+			t.Errorf("EulerGradus(%v) = %v, want %v", tt.interval, got, tt.gradus)
+		}
+		if got := tt.interval.OddLimit(); got != tt.oddLimit {
+			t.Errorf("OddLimit(%v) = %v, want %v", tt.interval, got, tt.oddLimit)
+		}
+		if got := tt.interval.PrimeLimit(); got != tt.prime {
+			t.Errorf("PrimeLimit(%v) = %v, want %v", tt.interval, got, tt.prime)
+		}
+	}
+}
+
+func Test_ShouldRankNamedIntervalsByTenneyHeight(t *testing.T) {
+	// When
+	ranked := RankIntervals(NamedIntervals(), JustInterval.TenneyHeight)
+
+	// Then
+	assert.Equal(t, []string{"Perfect Unison", "Perfect Octave", "Perfect Fifth", "Perfect Fourth", "Major Sixth"},
+		[]string{ranked[0].Name(), ranked[1].Name(), ranked[2].Name(), ranked[3].Name(), ranked[4].Name()})
+	assert.Equal(t, "Pythagorean Diminished Fifth", ranked[len(ranked)-1].Name())
+}
+
+func Test_ShouldRankIntervalsByIntegerMetric(t *testing.T) {
+	// When
+	ranked := RankIntervals([]JustInterval{NewInterval(7, 4), NewInterval(5, 4), NewInterval(3, 2)}, JustInterval.OddLimit)
+
+	// Then
+	assert.Equal(t, []JustInterval{NewInterval(3, 2), NewInterval(5, 4), NewInterval(7, 4)}, ranked)
+}
+
+func Test_ShouldFindFifthSmootherThanTritoneAndSemitone(t *testing.T) {
+	// Given
+	model := NewRoughnessModel(261.63, HarmonicSpectrum(6))
+
+	// When
+	fifth := model.Dyad(PerfectFifth())
+	tritone := model.Dyad(NewInterval(45, 32))
+	semitone := model.Dyad(NewInterval(16, 15))
+
+	// Then
+	assert.Less(t, fifth, tritone)
+	assert.Less(t, tritone, semitone)
+}
+
+func Test_ShouldFindJustFifthSmootherThanTemperedFifthsNearby(t *testing.T) {
+	// Given
+	model := NewRoughnessModel(261.63, HarmonicSpectrum(6))
+
+	// When
+	just := model.Dyad(PerfectFifth())
+	wide := model.TemperedDyad(TemperedInterval(math.Pow(2, 710.0/1200)))
+
+	// Then
+	assert.InDelta(t, just, model.TemperedDyad(TemperedInterval(1.5)), 1e-9)
+	assert.Less(t, just, wide)
+}
+
+func Test_ShouldFindMajorTriadSmootherThanMinorInJustAndEqualTemperament(t *testing.T) {
+	// Given
+	model := NewRoughnessModel(220, HarmonicSpectrum(8))
+	third := func(semitones float64) TemperedInterval { return TemperedInterval(math.Pow(2, semitones/12)) }
+
+	// When
+	justMajor := model.Chord([]JustInterval{Unison(), NewInterval(5, 4), PerfectFifth()})
+	justMinor := model.Chord([]JustInterval{Unison(), NewInterval(6, 5), PerfectFifth()})
+	temperedMajor := model.TemperedChord([]TemperedInterval{1, third(4), third(7)})
+	temperedMinor := model.TemperedChord([]TemperedInterval{1, third(3), third(7)})
+	clusterChord := model.Chord([]JustInterval{Unison(), NewInterval(16, 15), NewInterval(9, 8)})
+
+	// Then
+	assert.Less(t, justMajor, justMinor)
+	assert.Less(t, temperedMajor, temperedMinor)
+	assert.Less(t, justMajor, temperedMajor)
+	assert.Less(t, justMinor, temperedMinor)
+	assert.Less(t, temperedMinor, clusterChord)
+}
+
+func Test_ShouldHaveNoRoughnessForPureSineUnison(t *testing.T) {
+	// Given
+	model := NewRoughnessModel(440, []Partial{{Multiple: 1, Amplitude: 1}})
+
+	// Then
+	assert.Equal(t, 0.0, model.Ratios(1, 1))
+	assert.Greater(t, model.Ratios(1, 1.05), 0.0)
+}
@@ -0,0 +1,69 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// NewEulerFokkerGenus Euler-Fokker genus of a multiset of factors, such as [3 3 3 5 5 7]: every divisor of their
+// product octave reduced.
+// Reference: Adriaan Fokker, Just Intonation and the Division of the Octave into Thirty-One Equal Parts (1987)
+func NewEulerFokkerGenus(factors ...uint) (JustScale, error) {
+	if slices.Contains(factors, 0) {
+		return JustScale{}, fmt.Errorf("Euler-Fokker genus factors must be at least 1 but got %v", factors)
+	}
+	return JustScale{
+		system:      "Euler-Fokker Genus",
+		description: fmt.Sprintf("Euler-Fokker genus %s.", EulerFokkerGenusName(factors...)),
+		algorithm: func() []JustInterval {
+			return computeEulerFokkerGenus(factors)
+		},
+	}, nil
+}
+
+// EulerFokkerGenusName names a genus per Fokker's convention of listing its factors in ascending order, e.g. [333557].
+func EulerFokkerGenusName(factors ...uint) string {
+	sorted := slices.Clone(factors)
+	slices.Sort(sorted)
+	var name strings.Builder
+	for _, factor := range sorted {
+		name.WriteString(fmt.Sprintf("%d", factor))
+	}
+	return "[" + name.String() + "]"
+}
+
+func computeEulerFokkerGenus(factors []uint) []JustInterval {
+	var intervals []JustInterval
+	for _, divisor := range buildMultiplierTablesFrom(powersOfEachFactor(factors)...) {
+		intervals = appendIfMissing(intervals, FromIntArray(divisor).OctaveReduce().Simplify())
+	}
+	SortIntervals(intervals)
+	return append(intervals, Octave())
+}
+
+func powersOfEachFactor(factors []uint) [][][]uint {
+	exponents := map[uint]int{}
+	var distinctFactors []uint
+	for _, factor := range factors {
+		if exponents[factor] == 0 {
+			distinctFactors = append(distinctFactors, factor)
+		}
+		exponents[factor]++
+	}
+	if len(distinctFactors) == 0 {
+		return [][][]uint{{{1, 1}}}
+	}
+
+	var powers [][][]uint
+	for _, factor := range distinctFactors {
+		var powersOfFactor = [][]uint{{1, 1}}
+		var power uint = 1
+		for range exponents[factor] {
+			power *= factor
+			powersOfFactor = append(powersOfFactor, []uint{power, 1})
+		}
+		powers = append(powers, powersOfFactor)
+	}
+	return powers
+}
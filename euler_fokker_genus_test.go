@@ -0,0 +1,56 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldReturnEulerFokkerGenusOfMajorTriad(t *testing.T) {
+	// Given
+	scale, _ := NewEulerFokkerGenus(3, 5)
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Euler-Fokker Genus", scale.System())
+	assert.Equal(t, "Euler-Fokker genus [35].", scale.Description())
+	assert.Equal(t, []JustInterval{
+		{numerator: 1, denominator: 1},
+		{numerator: 5, denominator: 4},
+		{numerator: 3, denominator: 2},
+		{numerator: 15, denominator: 8},
+		{numerator: 2, denominator: 1},
+	}, intervals)
+}
+
+func Test_ShouldReturnAllDivisorsOfSeptimalEulerFokkerGenus(t *testing.T) {
+	// Given
+	scale, _ := NewEulerFokkerGenus(7, 3, 5, 3, 5, 3)
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Euler-Fokker genus [333557].", scale.Description())
+	assert.Equal(t, 25, len(intervals))
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, intervals[0])
+	assert.Contains(t, intervals, JustInterval{numerator: 4725, denominator: 4096})
+	assert.Contains(t, intervals, JustInterval{numerator: 7, denominator: 4})
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[24])
+	assert.True(t, slicesAreSorted(intervals))
+}
+
+func Test_ShouldReturnUnisonAndOctaveForEmptyEulerFokkerGenus(t *testing.T) {
+	scale, _ := NewEulerFokkerGenus()
+	assert.Equal(t, []JustInterval{Unison(), Octave()}, scale.Intervals())
+}
+
+func Test_ShouldReturnErrorForZeroFactorInEulerFokkerGenus(t *testing.T) {
+	// When
+	_, err := NewEulerFokkerGenus(3, 0)
+
+	// Then
+	assert.EqualError(t, err, "Euler-Fokker genus factors must be at least 1 but got [3 0]")
+}
@@ -0,0 +1,273 @@
+package music
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// LengthUnit the unit in which a fretboard's lengths are given and reported.
+type LengthUnit string
+
+const (
+	Millimetres LengthUnit = "mm"
+	Inches      LengthUnit = "in"
+)
+
+const millimetresPerInch = 25.4
+
+func (u LengthUnit) String() string {
+	return string(u)
+}
+
+func (u LengthUnit) isKnown() bool {
+	return u == Millimetres || u == Inches
+}
+
+// FretboardString one course of strings, tuned to a number of cents above the instrument's reference pitch.
+type FretboardString struct {
+	Name      string
+	OpenCents float64
+}
+
+// Fret a fret at a number of cents above the open string. Strings lists the strings it lies under, counting from
+// zero, or is empty for a fret across the whole fingerboard. A movable fret is tied on, as on the bağlama, and can be
+// moved with MoveFret.
+type Fret struct {
+	Cents   float64
+	Strings []int
+	Movable bool
+}
+
+// FretPosition where a fret lies, measured from the nut and from the fret before it.
+type FretPosition struct {
+	Number       int
+	Fret         Fret
+	FromNut      float64
+	FromPrevious float64
+}
+
+// Fretboard the frets that realise a scale on a fingerboard of a given scale length, with the nut moved towards the
+// bridge by nut compensation, which shortens every nut-to-fret distance by the same amount.
+type Fretboard struct {
+	scaleLength     float64
+	unit            LengthUnit
+	nutCompensation float64
+	frets           []Fret
+	strings         []FretboardString
+}
+
+// NewFretboard places frets at successive degrees of the scale above the open string, continuing into higher octaves
+// until there are as many frets as asked for. It fails for a scale length or number of frets of zero or less, or for
+// an unknown unit.
+func NewFretboard(scale CentsScale, scaleLength float64, unit LengthUnit, frets int) (Fretboard, error) {
+	if !(scaleLength > 0) {
+		return Fretboard{}, fmt.Errorf("fretboard needs a positive scale length but was given %g%s", scaleLength, unit)
+	}
+	if frets < 1 {
+		return Fretboard{}, fmt.Errorf("fretboard needs at least one fret but was given %d", frets)
+	}
+	if !unit.isKnown() {
+		return Fretboard{}, fmt.Errorf("fretboard cannot be measured in unknown unit %q", unit)
+	}
+	fretboard := Fretboard{scaleLength: scaleLength, unit: unit, strings: []FretboardString{{Name: "1"}}}
+	degrees := centsWithoutOctave(scale.Cents())
+	if len(degrees) == 0 {
+		return fretboard, nil
+	}
+	for number := 1; number <= frets; number++ {
+		cents := degrees[number%len(degrees)] + centsInOctave*float64(number/len(degrees))
+		fretboard.frets = append(fretboard.frets, Fret{Cents: cents})
+	}
+	return fretboard, nil
+}
+
+func (f Fretboard) ScaleLength() float64 {
+	return f.scaleLength
+}
+
+func (f Fretboard) Unit() LengthUnit {
+	return f.unit
+}
+
+func (f Fretboard) WithNutCompensation(distance float64) Fretboard {
+	f.nutCompensation = distance
+	return f
+}
+
+// WithStrings replaces the single default string with strings of the given open tunings.
+func (f Fretboard) WithStrings(strings ...FretboardString) Fretboard {
+	f.strings = slices.Clone(strings)
+	return f
+}
+
+func (f Fretboard) Strings() []FretboardString {
+	return f.strings
+}
+
+// WithTiedFrets makes every fret movable.
+func (f Fretboard) WithTiedFrets() Fretboard {
+	f.frets = slices.Clone(f.frets)
+	for i := range f.frets {
+		f.frets[i].Movable = true
+	}
+	return f
+}
+
+// WithPartialFret adds a movable fret at the given cents under only the listed strings, or under all when none are
+// listed.
+func (f Fretboard) WithPartialFret(cents float64, strings ...int) Fretboard {
+	if len(strings) == 0 {
+		strings = nil
+	}
+	f.frets = append(slices.Clone(f.frets), Fret{Cents: cents, Strings: slices.Clone(strings), Movable: true})
+	f.sortFrets()
+	return f
+}
+
+// MoveFret retunes the movable fret with the given number, counting from one at the nut.
+func (f Fretboard) MoveFret(number int, cents float64) (Fretboard, error) {
+	if number < 1 || number > len(f.frets) {
+		return Fretboard{}, fmt.Errorf("fretboard has no fret %d as it has %d frets", number, len(f.frets))
+	}
+	if !f.frets[number-1].Movable {
+		return Fretboard{}, fmt.Errorf("fret %d is fixed and cannot be moved", number)
+	}
+	f.frets = slices.Clone(f.frets)
+	f.frets[number-1].Cents = cents
+	f.sortFrets()
+	return f, nil
+}
+
+func (f *Fretboard) sortFrets() {
+	slices.SortStableFunc(f.frets, func(a, b Fret) int {
+		return cmp.Compare(a.Cents, b.Cents)
+	})
+}
+
+// InUnit the same fretboard with its lengths converted to another unit.
+func (f Fretboard) InUnit(unit LengthUnit) (Fretboard, error) {
+	if !unit.isKnown() {
+		return Fretboard{}, fmt.Errorf("cannot convert fretboard to unknown unit %q", unit)
+	}
+	factor := 1.0
+	switch {
+	case f.unit == Millimetres && unit == Inches:
+		factor = 1 / millimetresPerInch
+	case f.unit == Inches && unit == Millimetres:
+		factor = millimetresPerInch
+	}
+	f.scaleLength *= factor
+	f.nutCompensation *= factor
+	f.unit = unit
+	return f, nil
+}
+
+// Positions where each fret lies, numbered from one at the nut.
+func (f Fretboard) Positions() []FretPosition {
+	var positions []FretPosition
+	previous := 0.0
+	for i, fret := range f.frets {
+		fromNut := f.distanceFromNut(fret.Cents)
+		positions = append(positions, FretPosition{Number: i + 1, Fret: fret, FromNut: fromNut, FromPrevious: fromNut - previous})
+		previous = fromNut
+	}
+	return positions
+}
+
+func (f Fretboard) distanceFromNut(cents float64) float64 {
+	return f.scaleLength*(1-math.Pow(2, -cents/centsInOctave)) - f.nutCompensation
+}
+
+// StringPitches the cents above the reference pitch of the open string and each fret that lies under it.
+func (f Fretboard) StringPitches(index int) ([]float64, error) {
+	if index < 0 || index >= len(f.strings) {
+		return nil, fmt.Errorf("fretboard has no string %d as it has %d strings", index, len(f.strings))
+	}
+	open := f.strings[index].OpenCents
+	pitches := []float64{open}
+	for _, fret := range f.frets {
+		if len(fret.Strings) == 0 || slices.Contains(fret.Strings, index) {
+			pitches = append(pitches, open+fret.Cents)
+		}
+	}
+	return pitches, nil
+}
+
+// Table a printable table of the frets with their distances to two decimal places.
+func (f Fretboard) Table() string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(writer, "Fret\tCents\tFrom nut (%s)\tFrom previous (%s)\tStrings\t\n", f.unit, f.unit)
+	for _, position := range f.Positions() {
+		fmt.Fprintf(writer, "%d%s\t%s\t%.2f\t%.2f\t%s\t\n", position.Number, movableMark(position.Fret), formatCents(position.Fret.Cents),
+			position.FromNut, position.FromPrevious, f.stringsUnder(position.Fret))
+	}
+	writer.Flush()
+	return buffer.String()
+}
+
+// SVG a full-size template of the fingerboard from the nut to the last fret, for printing at 100% scale, with the
+// strings running across and partial frets drawn only under their strings.
+func (f Fretboard) SVG() string {
+	const stringSpacing, edge = 8.0, 6.0
+	factor := 1.0
+	if f.unit == Inches {
+		factor = millimetresPerInch
+	}
+	positions := f.Positions()
+	length := 20.0
+	if len(positions) > 0 {
+		length += positions[len(positions)-1].FromNut * factor
+	}
+	width := 2*edge + stringSpacing*float64(len(f.strings)-1)
+	y := func(index int) float64 { return edge + stringSpacing*float64(index) }
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%.1fmm" height="%.1fmm" viewBox="0 0 %.1f %.1f" font-family="sans-serif" font-size="3">`+"\n",
+		length, width+10, length, width+10)
+	fmt.Fprintf(&svg, `  <rect x="0" y="0" width="%.1f" height="%.1f" fill="none" stroke="black" stroke-width="0.2"/>`+"\n", length, width)
+	fmt.Fprintf(&svg, `  <line x1="0" y1="0" x2="0" y2="%.1f" stroke="black" stroke-width="1"/>`+"\n", width)
+	for i := range f.strings {
+		fmt.Fprintf(&svg, `  <line x1="0" y1="%.1f" x2="%.1f" y2="%.1f" stroke="grey" stroke-width="0.2"/>`+"\n", y(i), length, y(i))
+	}
+	for _, position := range positions {
+		x := position.FromNut * factor
+		top, bottom := 0.0, width
+		if len(position.Fret.Strings) > 0 {
+			top = y(slices.Min(position.Fret.Strings)) - stringSpacing/2
+			bottom = y(slices.Max(position.Fret.Strings)) + stringSpacing/2
+		}
+		fmt.Fprintf(&svg, `  <line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black" stroke-width="0.4"/>`+"\n", x, top, x, bottom)
+		fmt.Fprintf(&svg, `  <text x="%.2f" y="%.1f" text-anchor="middle">%d</text>`+"\n", x, width+5, position.Number)
+	}
+	svg.WriteString("</svg>\n")
+	return svg.String()
+}
+
+func (f Fretboard) stringsUnder(fret Fret) string {
+	if len(fret.Strings) == 0 {
+		return "all"
+	}
+	var names []string
+	for _, index := range fret.Strings {
+		if index >= 0 && index < len(f.strings) {
+			names = append(names, f.strings[index].Name)
+		} else {
+			names = append(names, strconv.Itoa(index))
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+func movableMark(fret Fret) string {
+	if fret.Movable {
+		return "*"
+	}
+	return ""
+}
@@ -0,0 +1,174 @@
+package music
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldPlaceEqualTemperedFretsOnGuitar(t *testing.T) {
+	// Given
+	fretboard, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 24)
+
+	// When
+	positions := fretboard.Positions()
+
+	// Then
+	assert.Equal(t, 24, len(positions))
+	assert.InDelta(t, 36.48, positions[0].FromNut, 0.01)
+	assert.InDelta(t, 36.48, positions[0].FromPrevious, 0.01)
+	assert.InDelta(t, 34.43, positions[1].FromPrevious, 0.01)
+	assert.InDelta(t, 325, positions[11].FromNut, 0.001)
+	assert.InDelta(t, 487.5, positions[23].FromNut, 0.001)
+	assert.Equal(t, 2400.0, positions[23].Fret.Cents)
+}
+
+func Test_ShouldShortenEveryNutDistanceByNutCompensation(t *testing.T) {
+	// Given
+	guitar, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 12)
+	fretboard := guitar.WithNutCompensation(0.5)
+
+	// When
+	positions := fretboard.Positions()
+
+	// Then
+	assert.InDelta(t, 35.98, positions[0].FromNut, 0.01)
+	assert.InDelta(t, 34.43, positions[1].FromPrevious, 0.01)
+	assert.InDelta(t, 324.5, positions[11].FromNut, 0.001)
+}
+
+func Test_ShouldConvertFretboardToInches(t *testing.T) {
+	// Given
+	fretboard, _ := NewFretboard(NewPythagoreanScale(), 647.7, Millimetres, 13)
+
+	// When
+	inches, err := fretboard.InUnit(Inches)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, Inches, inches.Unit())
+	assert.InDelta(t, 25.5, inches.ScaleLength(), 0.001)
+	assert.InDelta(t, 12.75, inches.Positions()[12].FromNut, 0.001)
+	assert.InDelta(t, 8.5, inches.Positions()[7].FromNut, 0.001)
+}
+
+func Test_ShouldFretSazWithTiedMovableAndPartialFrets(t *testing.T) {
+	// Given
+	saz, _ := NewFretboard(NewSazScale(), 880, Millimetres, 17)
+	fretboard := saz.
+		WithStrings(FretboardString{Name: "alt", OpenCents: 0}, FretboardString{Name: "orta", OpenCents: 498.04}, FretboardString{Name: "üst", OpenCents: 701.96}).
+		WithTiedFrets().
+		WithPartialFret(150, 0)
+
+	// When
+	moved, err := fretboard.MoveFret(2, 160)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 18, len(fretboard.Positions()))
+	assert.InDelta(t, 440, fretboard.Positions()[17].FromNut, 0.001)
+	assert.Equal(t, Fret{Cents: 150, Strings: []int{0}, Movable: true}, fretboard.Positions()[1].Fret)
+	assert.Equal(t, 160.0, moved.Positions()[2].Fret.Cents)
+
+	alt, _ := fretboard.StringPitches(0)
+	orta, _ := fretboard.StringPitches(1)
+	assert.Equal(t, 19, len(alt))
+	assert.Equal(t, 18, len(orta))
+	assert.InDelta(t, 1698.04, orta[17], 0.01)
+}
+
+func Test_ShouldNotMoveFixedOrMissingFrets(t *testing.T) {
+	// Given
+	fretboard, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 12)
+
+	// When
+	_, fixed := fretboard.MoveFret(1, 90)
+	_, missing := fretboard.MoveFret(13, 1300)
+	_, noString := fretboard.StringPitches(3)
+
+	// Then
+	assert.EqualError(t, fixed, "fret 1 is fixed and cannot be moved")
+	assert.EqualError(t, missing, "fretboard has no fret 13 as it has 12 frets")
+	assert.EqualError(t, noString, "fretboard has no string 3 as it has 1 strings")
+}
+
+func Test_ShouldPrintFretTable(t *testing.T) {
+	// Given
+	guitar, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 2)
+	fretboard := guitar.WithPartialFret(150, 0)
+
+	// When
+	table := fretboard.Table()
+
+	// Then
+	lines := strings.Split(strings.TrimRight(table, "\n"), "\n")
+	assert.Equal(t, 4, len(lines))
+	assert.Equal(t, "Fret   Cents  From nut (mm)  From previous (mm)  Strings", strings.TrimSpace(lines[0]))
+	assert.Equal(t, "1  100.00          36.48               36.48      all", strings.TrimSpace(lines[1]))
+	assert.Equal(t, "2*  150.00          53.95               17.47        1", strings.TrimSpace(lines[2]))
+}
+
+func Test_ShouldDrawFullSizeSVGTemplate(t *testing.T) {
+	// Given
+	guitar, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 12)
+	fretboard := guitar.
+		WithStrings(FretboardString{Name: "E"}, FretboardString{Name: "A", OpenCents: 500}).
+		WithPartialFret(150, 1)
+
+	// When
+	svg := fretboard.SVG()
+
+	// Then
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="345.0mm"`))
+	assert.Contains(t, svg, `<line x1="325.00" y1="0.00" x2="325.00" y2="20.00"`)
+	assert.Contains(t, svg, `<line x1="53.95" y1="10.00" x2="53.95" y2="18.00"`)
+	assert.Equal(t, 13, strings.Count(svg, "<text"))
+}
+
+func Test_ShouldTreatPartialFretWithNoStringsAsFullFret(t *testing.T) {
+	// Given
+	guitar, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 2)
+	fretboard := guitar.WithPartialFret(150, []int{}...)
+
+	// When
+	pitches, _ := fretboard.StringPitches(0)
+	svg := fretboard.SVG()
+
+	// Then
+	assert.Equal(t, []float64{0, 100, 150, 200}, pitches)
+	assert.Nil(t, fretboard.Positions()[1].Fret.Strings)
+	assert.Contains(t, svg, `<line x1="53.95" y1="0.00" x2="53.95" y2="12.00"`)
+}
+
+func Test_ShouldRejectInvalidFretboards(t *testing.T) {
+	tests := []struct {
+		name        string
+		scaleLength float64
+		unit        LengthUnit
+		frets       int
+		wantErr     string
+	}{
+		{name: "zero scale length", scaleLength: 0, unit: Millimetres, frets: 12, wantErr: "fretboard needs a positive scale length but was given 0mm"},
+		{name: "negative scale length", scaleLength: -650, unit: Millimetres, frets: 12, wantErr: "fretboard needs a positive scale length but was given -650mm"},
+		{name: "no frets", scaleLength: 650, unit: Millimetres, frets: 0, wantErr: "fretboard needs at least one fret but was given 0"},
+		{name: "unknown unit", scaleLength: 650, unit: LengthUnit("cubit"), frets: 12, wantErr: `fretboard cannot be measured in unknown unit "cubit"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFretboard(NewEqualTemperamentScale(12), tt.scaleLength, tt.unit, tt.frets)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_ShouldNotConvertFretboardToUnknownUnit(t *testing.T) {
+	// Given
+	fretboard, _ := NewFretboard(NewEqualTemperamentScale(12), 650, Millimetres, 12)
+
+	// When
+	_, err := fretboard.InUnit(LengthUnit("cubit"))
+
+	// Then
+	assert.EqualError(t, err, `cannot convert fretboard to unknown unit "cubit"`)
+}
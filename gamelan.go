@@ -0,0 +1,179 @@
+package music
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Laras string
+
+const (
+	Slendro Laras = "slendro"
+	Pelog   Laras = "pelog"
+)
+
+func (l Laras) String() string {
+	return string(l)
+}
+
+func (l Laras) title() string {
+	if l == "" {
+		return ""
+	}
+	return strings.ToUpper(string(l[:1])) + string(l[1:])
+}
+
+// NewSlendroScale an idealised slendro: five near-equal steps to the octave.
+func NewSlendroScale() TemperedScale {
+	return TemperedScale{
+		system:      "Gamelan Slendro",
+		description: "Idealised Javanese slendro of five equal steps.",
+		algorithm: func() []TemperedInterval {
+			return temperedIntervalsFromSteps([]int{0, 1, 2, 3, 4, 5}, 5)
+		},
+	}
+}
+
+// NewPelogScale an idealised pelog: seven notes taken from nine equal steps to the octave, small-small-large-small-
+// small-small-large.
+func NewPelogScale() TemperedScale {
+	return TemperedScale{
+		system:      "Gamelan Pelog",
+		description: "Idealised Javanese pelog of seven notes drawn from nine equal steps.",
+		algorithm: func() []TemperedInterval {
+			return temperedIntervalsFromSteps([]int{0, 1, 2, 4, 5, 6, 7, 9}, 9)
+		},
+	}
+}
+
+func temperedIntervalsFromSteps(steps []int, divisionsOfOctave int) []TemperedInterval {
+	var intervals []TemperedInterval
+	for _, step := range steps {
+		intervals = append(intervals, TemperedInterval(math.Exp2(float64(step)/float64(divisionsOfOctave))))
+	}
+	return intervals
+}
+
+// GamelanTuning the measured tuning of one ensemble's instruments in one laras, in cents above its first degree. The
+// last value is the measured octave (gembyang), which need not be 1200 cents and is not itself a degree.
+type GamelanTuning struct {
+	ensemble string
+	laras    Laras
+	cents    []float64
+}
+
+func NewGamelanTuning(ensemble string, laras Laras, cents ...float64) GamelanTuning {
+	return GamelanTuning{ensemble: ensemble, laras: laras, cents: cents}
+}
+
+// LoadGamelanTunings reads measured tunings as CSV records of ensemble, laras and the cents of each degree up to and
+// including the octave. Lines starting with # are comments.
+func LoadGamelanTunings(r io.Reader) ([]GamelanTuning, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var tunings []GamelanTuning
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return tunings, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read gamelan tunings: %w", err)
+		}
+		if len(record) < 4 {
+			return nil, fmt.Errorf("gamelan tuning %q needs an ensemble, a laras, at least one degree and the gembyang", strings.Join(record, ","))
+		}
+		laras := Laras(strings.ToLower(record[1]))
+		if laras != Slendro && laras != Pelog {
+			return nil, fmt.Errorf("gamelan tuning %s has unknown laras %q", record[0], record[1])
+		}
+		var cents []float64
+		for _, field := range record[2:] {
+			c, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("gamelan tuning %s has invalid cents %q: %w", record[0], field, err)
+			}
+			cents = append(cents, c)
+		}
+		tunings = append(tunings, NewGamelanTuning(record[0], laras, cents...))
+	}
+}
+
+func (g GamelanTuning) Ensemble() string {
+	return g.ensemble
+}
+
+func (g GamelanTuning) Laras() Laras {
+	return g.laras
+}
+
+// Cents the measurements as given, the gembyang included.
+func (g GamelanTuning) Cents() []float64 {
+	return g.cents
+}
+
+// Degrees the measured degrees in cents, without the gembyang.
+func (g GamelanTuning) Degrees() []float64 {
+	if len(g.cents) == 0 {
+		return nil
+	}
+	return g.cents[:len(g.cents)-1]
+}
+
+// Gembyang the measured octave in cents, often stretched a little beyond 1200.
+func (g GamelanTuning) Gembyang() float64 {
+	if len(g.cents) == 0 {
+		return 0
+	}
+	return g.cents[len(g.cents)-1]
+}
+
+// Scale the measured degrees closed by a true octave, so that the scale has only its own degrees wherever it is
+// used; the gembyang is given in the description.
+func (g GamelanTuning) Scale() TemperedScale {
+	return TemperedScale{
+		system:      fmt.Sprintf("Gamelan %s", g.laras.title()),
+		description: fmt.Sprintf("Measured %s tuning of %s with a gembyang of %s cents.", g.laras, g.ensemble, formatCents(g.Gembyang())),
+		algorithm: func() []TemperedInterval {
+			var intervals []TemperedInterval
+			for _, c := range g.Degrees() {
+				intervals = append(intervals, TemperedInterval(math.Exp2(c/centsInOctave)))
+			}
+			return append(intervals, 2.0)
+		},
+	}
+}
+
+// PairedFrequencies returns the frequencies of a pair of instruments tuned to the gamelan, where the pengisep is tuned
+// sharp of the pengumbang so that each pair of notes beats at ombak hertz.
+func (g GamelanTuning) PairedFrequencies(frequencyOfFirstDegree, ombak float64) (pengumbang, pengisep []float64) {
+	for _, c := range g.cents {
+		frequency := frequencyOfFirstDegree * math.Exp2(c/centsInOctave)
+		pengumbang = append(pengumbang, frequency)
+		pengisep = append(pengisep, frequency+ombak)
+	}
+	return pengumbang, pengisep
+}
+
+// DeviationsFromEqualTemperament returns, for each degree, how many cents it lies above (or below, if negative) the
+// nearest degree of the equal temperament, such as NewEqualTemperamentScale(5) for slendro or (9) for pelog. The last
+// is the gembyang's deviation from the nearest octave.
+func (g GamelanTuning) DeviationsFromEqualTemperament(divisionsOfOctave uint) ([]float64, error) {
+	if divisionsOfOctave == 0 {
+		return nil, fmt.Errorf("cannot compare gamelan tuning %s with an equal temperament of no divisions", g.ensemble)
+	}
+	step := centsInOctave / float64(divisionsOfOctave)
+	var deviations []float64
+	for _, c := range g.cents {
+		deviations = append(deviations, c-math.Round(c/step)*step)
+	}
+	return deviations, nil
+}
@@ -0,0 +1,125 @@
+package music
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldReturnIdealisedSlendroScale(t *testing.T) {
+	// Given
+	scale := NewSlendroScale()
+
+	// Then
+	assert.Equal(t, "Gamelan Slendro", scale.System())
+	assert.Equal(t, "Idealised Javanese slendro of five equal steps.", scale.Description())
+	assert.Equal(t, []float64{0, 240, 480, 720, 960, 1200}, scale.Cents())
+	assert.Equal(t, NewEqualTemperamentScale(5).Cents(), scale.Cents())
+}
+
+func Test_ShouldReturnIdealisedPelogScale(t *testing.T) {
+	// Given
+	scale := NewPelogScale()
+
+	// Then
+	assert.Equal(t, "Gamelan Pelog", scale.System())
+	assert.Equal(t, []float64{0, 133.33, 266.67, 533.33, 666.67, 800, 933.33, 1200}, scale.Cents())
+}
+
+func Test_ShouldLoadMeasuredGamelanTunings(t *testing.T) {
+	// Given
+	measurements := `# ensemble, laras, cents of each degree up to the octave
+Gamelan A, slendro, 0, 231, 474, 717, 955, 1208
+Gamelan A, Pelog, 0, 120, 258, 539, 675, 785, 943, 1206
+`
+
+	// When
+	tunings, err := LoadGamelanTunings(strings.NewReader(measurements))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tunings))
+	assert.Equal(t, "Gamelan A", tunings[0].Ensemble())
+	assert.Equal(t, Slendro, tunings[0].Laras())
+	assert.Equal(t, []float64{0, 231, 474, 717, 955, 1208}, tunings[0].Cents())
+	assert.Equal(t, Pelog, tunings[1].Laras())
+
+	scale := tunings[1].Scale()
+	assert.Equal(t, "Gamelan Pelog", scale.System())
+	assert.Equal(t, "Measured pelog tuning of Gamelan A with a gembyang of 1206.00 cents.", scale.Description())
+	assert.Equal(t, 8, len(scale.Cents()))
+	assert.InDelta(t, 943.0, scale.Cents()[6], 0.01)
+	assert.Equal(t, 1200.0, scale.Cents()[7])
+	assert.Equal(t, 1206.0, tunings[1].Gembyang())
+	assert.Equal(t, []float64{0, 120, 258, 539, 675, 785, 943}, tunings[1].Degrees())
+}
+
+func Test_ShouldRejectInvalidGamelanTunings(t *testing.T) {
+	tests := []struct {
+		name         string
+		measurements string
+		wantErr      string
+	}{
+		{
+			name:         "unknown laras",
+			measurements: "Gamelan B, degung, 0, 100",
+			wantErr:      `gamelan tuning Gamelan B has unknown laras "degung"`,
+		},
+		{
+			name:         "invalid cents",
+			measurements: "Gamelan B, pelog, 0, lots",
+			wantErr:      `gamelan tuning Gamelan B has invalid cents "lots": strconv.ParseFloat: parsing "lots": invalid syntax`,
+		},
+		{
+			name:         "missing degrees",
+			measurements: "Gamelan B, pelog, 0",
+			wantErr:      `gamelan tuning "Gamelan B,pelog,0" needs an ensemble, a laras, at least one degree and the gembyang`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadGamelanTunings(strings.NewReader(tt.measurements))
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_ShouldTunePengisepSharpOfPengumbangByOmbak(t *testing.T) {
+	// Given
+	tuning := NewGamelanTuning("Gamelan A", Slendro, 0, 240, 1200)
+
+	// When
+	pengumbang, pengisep := tuning.PairedFrequencies(220, 6)
+
+	// Then
+	assert.InDeltaSlice(t, []float64{220, 252.71, 440}, pengumbang, 0.01)
+	assert.InDeltaSlice(t, []float64{226, 258.71, 446}, pengisep, 0.01)
+}
+
+func Test_ShouldCompareMeasuredTuningWithEqualTemperaments(t *testing.T) {
+	// Given
+	tuning := NewGamelanTuning("Gamelan A", Pelog, 0, 120, 258, 539, 1206)
+
+	// When
+	nine, _ := tuning.DeviationsFromEqualTemperament(9)
+	five, _ := tuning.DeviationsFromEqualTemperament(5)
+	_, err := tuning.DeviationsFromEqualTemperament(0)
+
+	// Then
+	assert.InDeltaSlice(t, []float64{0, -13.33, -8.67, 5.67, 6}, nine, 0.01)
+	assert.InDeltaSlice(t, []float64{0, -120, 18, 59, 6}, five, 0.01)
+	assert.EqualError(t, err, "cannot compare gamelan tuning Gamelan A with an equal temperament of no divisions")
+}
+
+func Test_ShouldKeepGembyangOutOfScaleDegrees(t *testing.T) {
+	// Given
+	tuning := NewGamelanTuning("Gamelan A", Slendro, 0, 231, 474, 717, 955, 1208)
+
+	// When
+	comparison, _ := CompareScales(AlignByIndex, NewSlendroScale(), tuning.Scale())
+
+	// Then
+	assert.Equal(t, 5, len(comparison.Degrees()))
+	assert.Equal(t, 5, len(centsWithoutOctave(tuning.Scale().Cents())))
+}
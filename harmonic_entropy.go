@@ -0,0 +1,203 @@
+package music
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+)
+
+// EntropyWeighting how much each candidate ratio contributes before the spread is applied.
+type EntropyWeighting string
+
+const (
+	// WeightByMediants weights each ratio by the width in cents between the mediants it shares with its neighbours,
+	// as in Erlich's original Farey series model.
+	WeightByMediants EntropyWeighting = "mediants"
+	// WeightBySqrtHeight weights each ratio by 1/√(n·d), as usually paired with Tenney-bounded candidates.
+	WeightBySqrtHeight EntropyWeighting = "sqrt height"
+	// WeightEqually gives every ratio the same weight.
+	WeightEqually EntropyWeighting = "equal"
+)
+
+func (w EntropyWeighting) String() string {
+	return string(w)
+}
+
+// DefaultEntropySpread Erlich's usual standard deviation of pitch perception of about 1%, in cents.
+const DefaultEntropySpread = 17.0
+
+// maximumCandidateCents keeps ratios a little beyond the octave so the curve does not fall away at 1200 cents.
+const maximumCandidateCents = 1500.0
+
+// HarmonicEntropy Paul Erlich's harmonic entropy: how uncertain it is which simple ratio a heard interval is
+// approximating, given a normal distribution of spread s cents around it. Lower entropy sounds more just. Entropy is
+// in nats.
+// Reference: Paul Erlich, "On Harmonic Entropy" (Tuning List, 1997); https://en.xen.wiki/w/Harmonic_entropy
+type HarmonicEntropy struct {
+	candidates []JustInterval
+	cents      []float64
+	weights    []float64
+	spread     float64
+	weighting  EntropyWeighting
+}
+
+// NewFareyHarmonicEntropy takes as candidates every ratio from 1/1 upwards whose denominator is at most order.
+func NewFareyHarmonicEntropy(order uint, spread float64, weighting EntropyWeighting) (HarmonicEntropy, error) {
+	var candidates []JustInterval
+	for denominator := uint(1); denominator <= order; denominator++ {
+		for numerator := denominator; ; numerator++ {
+			interval := JustInterval{numerator: numerator, denominator: denominator}
+			if interval.ToCents() > maximumCandidateCents {
+				break
+			}
+			if interval.Simplify().denominator == denominator {
+				candidates = append(candidates, interval)
+			}
+		}
+	}
+	return newHarmonicEntropy(candidates, spread, weighting)
+}
+
+// NewTenneyHarmonicEntropy takes as candidates every ratio from 1/1 upwards whose Benedetti height n·d is at most limit.
+func NewTenneyHarmonicEntropy(limit uint, spread float64, weighting EntropyWeighting) (HarmonicEntropy, error) {
+	var candidates []JustInterval
+	for denominator := uint(1); denominator*denominator <= limit; denominator++ {
+		for numerator := denominator; numerator*denominator <= limit; numerator++ {
+			interval := JustInterval{numerator: numerator, denominator: denominator}
+			if interval.ToCents() <= maximumCandidateCents && interval.Simplify().denominator == denominator {
+				candidates = append(candidates, interval)
+			}
+		}
+	}
+	return newHarmonicEntropy(candidates, spread, weighting)
+}
+
+// newHarmonicEntropy fails for a spread that is not positive, or for fewer than two candidates, as a single ratio would
+// leave no uncertainty and every interval would seem perfectly just.
+func newHarmonicEntropy(candidates []JustInterval, spread float64, weighting EntropyWeighting) (HarmonicEntropy, error) {
+	if !(spread > 0) {
+		return HarmonicEntropy{}, fmt.Errorf("harmonic entropy needs a positive spread but was given %g cents", spread)
+	}
+	if len(candidates) < 2 {
+		return HarmonicEntropy{}, fmt.Errorf("harmonic entropy needs at least two candidate ratios but has %d", len(candidates))
+	}
+	SortIntervals(candidates)
+	entropy := HarmonicEntropy{candidates: candidates, spread: spread, weighting: weighting}
+	for _, candidate := range candidates {
+		entropy.cents = append(entropy.cents, candidate.ToCents())
+	}
+	for i, candidate := range candidates {
+		entropy.weights = append(entropy.weights, entropy.weightOf(i, candidate))
+	}
+	return entropy, nil
+}
+
+func (h HarmonicEntropy) weightOf(i int, candidate JustInterval) float64 {
+	switch h.weighting {
+	case WeightByMediants:
+		lower, upper := h.cents[i], h.cents[i]
+		if i > 0 {
+			lower = mediantCents(h.candidates[i-1], candidate)
+		}
+		if i < len(h.candidates)-1 {
+			upper = mediantCents(candidate, h.candidates[i+1])
+		}
+		return upper - lower
+	case WeightBySqrtHeight:
+		return 1 / math.Sqrt(float64(candidate.BenedettiHeight()))
+	default:
+		return 1
+	}
+}
+
+func mediantCents(a, b JustInterval) float64 {
+	return JustInterval{numerator: a.numerator + b.numerator, denominator: a.denominator + b.denominator}.ToCents()
+}
+
+func (h HarmonicEntropy) Candidates() []JustInterval {
+	return h.candidates
+}
+
+// Cents the harmonic entropy of an interval of the given size.
+func (h HarmonicEntropy) Cents(cents float64) float64 {
+	probabilities := make([]float64, len(h.candidates))
+	total := 0.0
+	for i, candidate := range h.cents {
+		distance := (cents - candidate) / h.spread
+		probabilities[i] = h.weights[i] * math.Exp(-distance*distance/2)
+		total += probabilities[i]
+	}
+
+	entropy := 0.0
+	for _, probability := range probabilities {
+		if probability /= total; probability > 0 {
+			entropy -= probability * math.Log(probability)
+		}
+	}
+	return entropy
+}
+
+func (h HarmonicEntropy) TemperedInterval(interval TemperedInterval) float64 {
+	return h.Cents(interval.ToCents())
+}
+
+func (h HarmonicEntropy) JustInterval(interval JustInterval) float64 {
+	return h.Cents(interval.ToCents())
+}
+
+// Scale the harmonic entropy of each degree of a scale above its tonic.
+func (h HarmonicEntropy) Scale(scale CentsScale) []float64 {
+	var entropies []float64
+	for _, cents := range scale.Cents() {
+		entropies = append(entropies, h.Cents(cents))
+	}
+	return entropies
+}
+
+// EntropyPoint one point of a harmonic entropy curve.
+type EntropyPoint struct {
+	Cents   float64
+	Entropy float64
+}
+
+// Curve the harmonic entropy from 0 to 1200 cents inclusive in steps of the given size, or nil unless the step is
+// positive.
+func (h HarmonicEntropy) Curve(step float64) []EntropyPoint {
+	if !(step > 0) {
+		return nil
+	}
+	var curve []EntropyPoint
+	for i := 0; float64(i)*step <= centsInOctave; i++ {
+		cents := float64(i) * step
+		curve = append(curve, EntropyPoint{Cents: cents, Entropy: h.Cents(cents)})
+	}
+	return curve
+}
+
+func (h HarmonicEntropy) CurveCSV(step float64) (string, error) {
+	if !(step > 0) {
+		return "", fmt.Errorf("cannot write harmonic entropy curve in steps of %s cents as the step must be positive", formatCents(step))
+	}
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	records := [][]string{{"cents", "entropy"}}
+	for _, point := range h.Curve(step) {
+		records = append(records, []string{formatCents(point.Cents), fmt.Sprintf("%.6f", point.Entropy)})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return "", fmt.Errorf("cannot write harmonic entropy curve as CSV: %w", err)
+	}
+	return buffer.String(), nil
+}
+
+// LocalMinima the points of the curve lower than both their neighbours, where the ratios that sound most just lie.
+func LocalMinima(curve []EntropyPoint) []EntropyPoint {
+	var minima []EntropyPoint
+	for i := 1; i < len(curve)-1; i++ {
+		if curve[i].Entropy < curve[i-1].Entropy && curve[i].Entropy < curve[i+1].Entropy {
+			minima = append(minima, curve[i])
+		}
+	}
+	return minima
+}
@@ -0,0 +1,121 @@
+package music
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldBuildFareyCandidatesUpToOrder(t *testing.T) {
+	// When
+	entropy, _ := NewFareyHarmonicEntropy(2, DefaultEntropySpread, WeightByMediants)
+
+	// Then
+	assert.Equal(t, []JustInterval{Unison(), PerfectFifth(), Octave()}, entropy.Candidates())
+}
+
+func Test_ShouldBuildTenneyCandidatesUpToHeight(t *testing.T) {
+	// When
+	entropy, _ := NewTenneyHarmonicEntropy(12, DefaultEntropySpread, WeightBySqrtHeight)
+
+	// Then
+	assert.Equal(t, []JustInterval{Unison(), NewInterval(4, 3), PerfectFifth(), Octave()}, entropy.Candidates())
+}
+
+func Test_ShouldFindJustIntervalsLowerInEntropyThanTheirNeighbours(t *testing.T) {
+	// Given
+	entropy, _ := NewTenneyHarmonicEntropy(10000, DefaultEntropySpread, WeightBySqrtHeight)
+
+	// Then
+	assert.Less(t, entropy.JustInterval(PerfectFifth()), entropy.Cents(680))
+	assert.Less(t, entropy.JustInterval(NewInterval(5, 4)), entropy.Cents(350))
+	assert.Less(t, entropy.TemperedInterval(TemperedInterval(1.5)), entropy.Cents(600))
+}
+
+func Test_ShouldScoreBachFifthBelowItsTritone(t *testing.T) {
+	// Given
+	entropy, _ := NewFareyHarmonicEntropy(60, DefaultEntropySpread, WeightByMediants)
+
+	// When
+	entropies := entropy.Scale(NewBachWohltemperierteKlavierScale())
+
+	// Then
+	assert.Equal(t, 13, len(entropies))
+	assert.Less(t, entropies[7], entropies[6])
+	assert.Less(t, entropies[5], entropies[6])
+}
+
+func Test_ShouldExportCurveWithMinimumAtFifth(t *testing.T) {
+	// Given
+	entropy, _ := NewTenneyHarmonicEntropy(10000, DefaultEntropySpread, WeightBySqrtHeight)
+
+	// When
+	curve := entropy.Curve(1)
+
+	// Then
+	assert.Equal(t, 1201, len(curve))
+	assert.Equal(t, 1200.0, curve[1200].Cents)
+	minima := LocalMinima(curve)
+	assert.True(t, containsMinimumNear(minima, 702), "expected a minimum near 702 cents in %v", minima)
+	assert.True(t, containsMinimumNear(minima, 498), "expected a minimum near 498 cents in %v", minima)
+}
+
+func Test_ShouldWriteCurveAsCSV(t *testing.T) {
+	// Given
+	entropy, _ := NewTenneyHarmonicEntropy(100, DefaultEntropySpread, WeightEqually)
+
+	// When
+	csv, err := entropy.CurveCSV(600)
+
+	// Then
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(csv), "\n")
+	assert.Equal(t, 4, len(lines))
+	assert.Equal(t, "cents,entropy", lines[0])
+	assert.True(t, strings.HasPrefix(lines[2], "600.00,"))
+}
+
+func Test_ShouldRejectCurveStepsThatAreNotPositive(t *testing.T) {
+	// Given
+	entropy, _ := NewTenneyHarmonicEntropy(100, DefaultEntropySpread, WeightEqually)
+
+	// When
+	_, zeroErr := entropy.CurveCSV(0)
+	_, negativeErr := entropy.CurveCSV(-10)
+
+	// Then
+	assert.Nil(t, entropy.Curve(0))
+	assert.Nil(t, entropy.Curve(-10))
+	assert.EqualError(t, zeroErr, "cannot write harmonic entropy curve in steps of 0.00 cents as the step must be positive")
+	assert.EqualError(t, negativeErr, "cannot write harmonic entropy curve in steps of -10.00 cents as the step must be positive")
+}
+
+func containsMinimumNear(minima []EntropyPoint, cents float64) bool {
+	for _, minimum := range minima {
+		if minimum.Cents >= cents-3 && minimum.Cents <= cents+3 {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_ShouldRejectSpreadThatIsNotPositive(t *testing.T) {
+	// When
+	_, zero := NewFareyHarmonicEntropy(10, 0, WeightByMediants)
+	_, negative := NewTenneyHarmonicEntropy(100, -17, WeightEqually)
+
+	// Then
+	assert.EqualError(t, zero, "harmonic entropy needs a positive spread but was given 0 cents")
+	assert.EqualError(t, negative, "harmonic entropy needs a positive spread but was given -17 cents")
+}
+
+func Test_ShouldRejectTooFewCandidateRatios(t *testing.T) {
+	// When
+	_, none := NewFareyHarmonicEntropy(0, DefaultEntropySpread, WeightByMediants)
+	_, one := NewTenneyHarmonicEntropy(1, DefaultEntropySpread, WeightEqually)
+
+	// Then
+	assert.EqualError(t, none, "harmonic entropy needs at least two candidate ratios but has 0")
+	assert.EqualError(t, one, "harmonic entropy needs at least two candidate ratios but has 1")
+}
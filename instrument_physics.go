@@ -0,0 +1,237 @@
+package music
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"text/tabwriter"
+)
+
+// Lengths, diameters and radii given to and returned by the physics helpers are in metres, frequencies in hertz,
+// temperatures in degrees Celsius and tensions in newtons. A CutList converts its lengths to a LengthUnit.
+
+const (
+	absoluteZeroCelsius     = -273.15
+	speedOfSoundAtZero      = 331.3
+	unflangedEndCorrection  = 0.6133
+	freeFreeFundamentalRoot = 4.730040745
+	millimetresPerMetre     = 1000.0
+)
+
+// SpeedOfSound the speed of sound in dry air at a given temperature, about 343.2m/s at 20°C.
+func SpeedOfSound(celsius float64) float64 {
+	return speedOfSoundAtZero * math.Sqrt(1+celsius/-absoluteZeroCelsius)
+}
+
+// Material the properties of a material from which strings, bars and tubes are made. Values in the catalogue are
+// typical; stock varies, so measure a test piece before cutting a whole set.
+type Material struct {
+	Name          string
+	Density       float64 // kilograms per cubic metre
+	YoungsModulus float64 // pascals
+}
+
+var (
+	Steel          = Material{Name: "Steel", Density: 7850, YoungsModulus: 200e9}
+	StainlessSteel = Material{Name: "Stainless steel", Density: 8000, YoungsModulus: 193e9}
+	Aluminium      = Material{Name: "Aluminium", Density: 2700, YoungsModulus: 69e9}
+	Brass          = Material{Name: "Brass", Density: 8500, YoungsModulus: 100e9}
+	PhosphorBronze = Material{Name: "Phosphor bronze", Density: 8860, YoungsModulus: 110e9}
+	Copper         = Material{Name: "Copper", Density: 8960, YoungsModulus: 117e9}
+	Nylon          = Material{Name: "Nylon", Density: 1140, YoungsModulus: 3e9}
+	Gut            = Material{Name: "Gut", Density: 1300, YoungsModulus: 5e9}
+	Rosewood       = Material{Name: "Rosewood", Density: 1000, YoungsModulus: 20e9}
+)
+
+// Materials returns every material in the catalogue.
+func Materials() []Material {
+	return []Material{Steel, StainlessSteel, Aluminium, Brass, PhosphorBronze, Copper, Nylon, Gut, Rosewood}
+}
+
+// Resonator anything whose sounding length can be found for a frequency, so that it can be cut to a scale.
+type Resonator interface {
+	Length(frequency float64) float64
+}
+
+// PipeType whether a pipe is open at both ends, as a flute or open organ pipe, or stopped at one, as a pan pipe or
+// stopped organ pipe, which sounds an octave lower for the same length.
+type PipeType string
+
+const (
+	OpenPipe   PipeType = "open"
+	ClosedPipe PipeType = "closed"
+)
+
+func (p PipeType) String() string {
+	return string(p)
+}
+
+// Pipe a cylindrical air column. Each open end sounds as though the pipe were longer by 0.6133 of its radius, the
+// end correction of an unflanged pipe; a flue pipe's mouth or a flute's embouchure adds more, which is best found by
+// trial.
+// Reference: H. Levine and J. Schwinger, "On the Radiation of Sound from an Unflanged Circular Pipe", Phys. Rev. 73 (1948)
+type Pipe struct {
+	Type        PipeType
+	Radius      float64
+	Temperature float64
+}
+
+// Length the physical length of pipe that sounds the frequency as its fundamental.
+func (p Pipe) Length(frequency float64) float64 {
+	return p.acousticLength(frequency) - p.endCorrection()
+}
+
+// Frequency the fundamental of a pipe of the given physical length.
+func (p Pipe) Frequency(length float64) float64 {
+	acousticLength := length + p.endCorrection()
+	if p.Type == ClosedPipe {
+		return SpeedOfSound(p.Temperature) / (4 * acousticLength)
+	}
+	return SpeedOfSound(p.Temperature) / (2 * acousticLength)
+}
+
+func (p Pipe) acousticLength(frequency float64) float64 {
+	if p.Type == ClosedPipe {
+		return SpeedOfSound(p.Temperature) / (4 * frequency)
+	}
+	return SpeedOfSound(p.Temperature) / (2 * frequency)
+}
+
+func (p Pipe) endCorrection() float64 {
+	if p.Type == ClosedPipe {
+		return unflangedEndCorrection * p.Radius
+	}
+	return 2 * unflangedEndCorrection * p.Radius
+}
+
+// FreeFreeBar a uniform bar or tube supported at its nodes and free at both ends, as in a glockenspiel, an untuned
+// marimba bar or a chime. Its fundamental is (β²κ / 2πL²)·√(E/ρ), where β = 4.7300 and κ is the radius of gyration
+// of its cross-section, so that for a given material and section the length goes as the inverse square root of the
+// frequency. Marimba and vibraphone bars are undercut, and tubular bells are heard at a strike tone set by higher
+// modes, so for both the lengths this gives are starting points.
+// Reference: Neville H. Fletcher and Thomas D. Rossing, The Physics of Musical Instruments (2nd ed., 1998), chapter 2
+type FreeFreeBar struct {
+	Material         Material
+	RadiusOfGyration float64
+}
+
+// NewRectangularBar a bar whose radius of gyration depends only on its thickness in the direction it vibrates.
+func NewRectangularBar(material Material, thickness float64) FreeFreeBar {
+	return FreeFreeBar{Material: material, RadiusOfGyration: thickness / math.Sqrt(12)}
+}
+
+// NewTube a round tube, or a solid rod when the inner diameter is zero.
+func NewTube(material Material, outerDiameter, innerDiameter float64) FreeFreeBar {
+	return FreeFreeBar{Material: material, RadiusOfGyration: math.Hypot(outerDiameter, innerDiameter) / 4}
+}
+
+func (b FreeFreeBar) Length(frequency float64) float64 {
+	return freeFreeFundamentalRoot * math.Sqrt(b.RadiusOfGyration*b.speedOfSound()/(2*math.Pi*frequency))
+}
+
+func (b FreeFreeBar) Frequency(length float64) float64 {
+	return freeFreeFundamentalRoot * freeFreeFundamentalRoot * b.RadiusOfGyration * b.speedOfSound() / (2 * math.Pi * length * length)
+}
+
+// speedOfSound the speed of longitudinal waves in the bar's material.
+func (b FreeFreeBar) speedOfSound() float64 {
+	return math.Sqrt(b.Material.YoungsModulus / b.Material.Density)
+}
+
+// VibratingString a plain round string of a given diameter held at a given tension, whose fundamental is
+// (1/2L)·√(T/μ), where μ is its mass per unit length. Wound strings are heavier than their diameter suggests.
+type VibratingString struct {
+	Material Material
+	Diameter float64
+	Tension  float64
+}
+
+// Length the vibrating length at which the string sounds the frequency at its tension.
+func (s VibratingString) Length(frequency float64) float64 {
+	return math.Sqrt(s.Tension/s.massPerLength()) / (2 * frequency)
+}
+
+// TensionFor the tension at which a vibrating length of the string sounds the frequency.
+func (s VibratingString) TensionFor(frequency, length float64) float64 {
+	return math.Pow(2*length*frequency, 2) * s.massPerLength()
+}
+
+func (s VibratingString) massPerLength() float64 {
+	return s.Material.Density * math.Pi * s.Diameter * s.Diameter / 4
+}
+
+// Cut one piece of a cut list: the degree of the scale, its pitch and the length to cut for it.
+type Cut struct {
+	Degree    int
+	Cents     float64
+	Frequency float64
+	Length    float64
+}
+
+// CutList the lengths of pipe, bar, tube or string needed to sound every degree of a scale, octave included.
+type CutList struct {
+	unit LengthUnit
+	cuts []Cut
+}
+
+// NewCutList tunes the scale's first degree to the reference pitch in hertz and reports lengths in the given unit. It
+// fails if a degree would need a length of zero or less, as happens when a pipe is too wide for its pitch.
+func NewCutList(scale CentsScale, referencePitch float64, resonator Resonator, unit LengthUnit) (CutList, error) {
+	list := CutList{unit: unit}
+	for degree, cents := range scale.Cents() {
+		frequency := referencePitch * math.Exp2(cents/centsInOctave)
+		length := resonator.Length(frequency)
+		if !(length > 0) {
+			return CutList{}, fmt.Errorf("cannot cut degree %d of %s at %.2fHz as it needs a length of %.4fm", degree, scale.System(), frequency, length)
+		}
+		list.cuts = append(list.cuts, Cut{Degree: degree, Cents: cents, Frequency: frequency, Length: metresIn(unit, length)})
+	}
+	return list, nil
+}
+
+func (c CutList) Cuts() []Cut {
+	return c.cuts
+}
+
+func (c CutList) Unit() LengthUnit {
+	return c.unit
+}
+
+// Table a printable cut list with frequencies and lengths to two decimal places.
+func (c CutList) Table() string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(writer, "Degree\tCents\tFrequency (Hz)\tLength (%s)\t\n", c.unit)
+	for _, cut := range c.cuts {
+		fmt.Fprintf(writer, "%d\t%s\t%.2f\t%.2f\t\n", cut.Degree, formatCents(cut.Cents), cut.Frequency, cut.Length)
+	}
+	writer.Flush()
+	return buffer.String()
+}
+
+func (c CutList) CSV() (string, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	records := [][]string{{"degree", "cents", "frequency", "length (" + c.unit.String() + ")"}}
+	for _, cut := range c.cuts {
+		records = append(records, []string{
+			strconv.Itoa(cut.Degree),
+			formatCents(cut.Cents),
+			strconv.FormatFloat(cut.Frequency, 'f', 3, 64),
+			strconv.FormatFloat(cut.Length, 'f', 2, 64),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return "", fmt.Errorf("cannot write cut list as CSV: %w", err)
+	}
+	return buffer.String(), nil
+}
+
+func metresIn(unit LengthUnit, metres float64) float64 {
+	if unit == Inches {
+		return metres * millimetresPerMetre / millimetresPerInch
+	}
+	return metres * millimetresPerMetre
+}
@@ -0,0 +1,105 @@
+package music
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldRaiseSpeedOfSoundWithTemperature(t *testing.T) {
+	assert.InDelta(t, 331.3, SpeedOfSound(0), 0.001)
+	assert.InDelta(t, 343.21, SpeedOfSound(20), 0.01)
+	assert.Greater(t, SpeedOfSound(30), SpeedOfSound(20))
+}
+
+func Test_ShouldShortenPipesByEndCorrection(t *testing.T) {
+	// Given
+	open := Pipe{Type: OpenPipe, Radius: 0.01, Temperature: 20}
+	closed := Pipe{Type: ClosedPipe, Radius: 0.01, Temperature: 20}
+
+	// When
+	openLength := open.Length(440)
+	closedLength := closed.Length(440)
+
+	// Then
+	assert.InDelta(t, 0.3778, openLength, 0.0001)
+	assert.InDelta(t, 0.1889, closedLength, 0.0001)
+	assert.InDelta(t, 440, open.Frequency(openLength), 1e-9)
+	assert.InDelta(t, 440, closed.Frequency(closedLength), 1e-9)
+}
+
+func Test_ShouldHalveFreeFreeBarFrequencyAtRootTwoTimesLength(t *testing.T) {
+	// Given
+	bar := NewRectangularBar(Aluminium, 0.01)
+
+	// When
+	length := bar.Length(440)
+
+	// Then
+	assert.InDelta(t, 0.3437, length, 0.0001)
+	assert.InDelta(t, 220, bar.Frequency(length*1.4142135623730951), 1e-6)
+	assert.InDelta(t, 0.5988, NewTube(Brass, 0.038, 0.035).Length(440), 0.0001)
+}
+
+func Test_ShouldFindTensionForStringLength(t *testing.T) {
+	// Given
+	str := VibratingString{Material: Steel, Diameter: 0.00025, Tension: 70}
+
+	// When
+	length := str.Length(329.63)
+
+	// Then
+	assert.InDelta(t, 0.6465, length, 0.0001)
+	assert.InDelta(t, 70, str.TensionFor(329.63, length), 1e-9)
+	assert.InDelta(t, 28.48, VibratingString{Material: Nylon, Diameter: 0.0007}.TensionFor(196, 0.65), 0.01)
+}
+
+func Test_ShouldCutOpenPipesForPythagoreanScale(t *testing.T) {
+	// When
+	list, err := NewCutList(New5LimitPythagoreanScale(), 261.63, Pipe{Type: OpenPipe, Radius: 0.008, Temperature: 20}, Millimetres)
+
+	// Then
+	assert.NoError(t, err)
+	cuts := list.Cuts()
+	assert.Equal(t, 14, len(cuts))
+	assert.Equal(t, Millimetres, list.Unit())
+	assert.InDelta(t, 646.10, cuts[0].Length, 0.01)
+	assert.InDelta(t, 318.15, cuts[len(cuts)-1].Length, 0.01)
+}
+
+func Test_ShouldCutSazScaleChimesInInches(t *testing.T) {
+	// When
+	list, err := NewCutList(NewSazScale(), 440, NewTube(Aluminium, 0.025, 0.022), Inches)
+
+	// Then
+	assert.NoError(t, err)
+	cuts := list.Cuts()
+	assert.Equal(t, 18, len(cuts))
+	assert.InDelta(t, 22.98, cuts[0].Length, 0.01)
+	lines := strings.Split(strings.TrimRight(list.Table(), "\n"), "\n")
+	assert.Equal(t, []string{"Degree", "Cents", "Frequency", "(Hz)", "Length", "(in)"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"0", "0.00", "440.00", "22.98"}, strings.Fields(lines[1]))
+}
+
+func Test_ShouldRenderCutListAsCSV(t *testing.T) {
+	// Given
+	list, _ := NewCutList(NewEqualTemperamentScale(12), 440, NewRectangularBar(Rosewood, 0.02), Millimetres)
+
+	// When
+	csv, err := list.CSV()
+
+	// Then
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimRight(csv, "\n"), "\n")
+	assert.Equal(t, "degree,cents,frequency,length (mm)", lines[0])
+	assert.Equal(t, "12,1200.00,880.000,323.23", lines[13])
+}
+
+func Test_ShouldNotCutPipeTooWideForItsPitch(t *testing.T) {
+	// When
+	_, err := NewCutList(NewEqualTemperamentScale(12), 4000, Pipe{Type: OpenPipe, Radius: 0.05, Temperature: 20}, Millimetres)
+
+	// Then
+	assert.EqualError(t, err, "cannot cut degree 0 of Equal Temperament at 4000.00Hz as it needs a length of -0.0184m")
+}
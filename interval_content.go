@@ -0,0 +1,141 @@
+package music
+
+import (
+	"math"
+	"slices"
+)
+
+// IntervalContent every interval between every ordered pair of degrees of a scale within one octave, where
+// Matrix()[i][j] is the ascending interval from degree i to degree j (wrapping into the next octave when j < i).
+type IntervalContent struct {
+	just  [][]JustInterval
+	cents [][]float64
+}
+
+func (s JustScale) IntervalContent() IntervalContent {
+	degrees := withoutOctave(s.Intervals())
+	content := IntervalContent{}
+	for i, from := range degrees {
+		var justRow []JustInterval
+		var centsRow []float64
+		for j, to := range degrees {
+			if j < i {
+				to = to.Add(Octave())
+			}
+			interval := to.Subtract(from)
+			justRow = append(justRow, interval)
+			centsRow = append(centsRow, interval.ToCents())
+		}
+		content.just = append(content.just, justRow)
+		content.cents = append(content.cents, centsRow)
+	}
+	return content
+}
+
+func (s TemperedScale) IntervalContent() IntervalContent {
+	return intervalContentOfCents(centsWithoutOctave(s.Cents()))
+}
+
+func intervalContentOfCents(degrees []float64) IntervalContent {
+	content := IntervalContent{}
+	for i, from := range degrees {
+		var row []float64
+		for j, to := range degrees {
+			if j < i {
+				to += centsInOctave
+			}
+			row = append(row, to-from)
+		}
+		content.cents = append(content.cents, row)
+	}
+	return content
+}
+
+// Matrix returns the intervals as JustIntervals, and false when the scale is tempered and they are only known in cents.
+func (c IntervalContent) Matrix() ([][]JustInterval, bool) {
+	return c.just, c.just != nil
+}
+
+func (c IntervalContent) CentsMatrix() [][]float64 {
+	return c.cents
+}
+
+// IntervalClassVector counts the interval classes 1 to 6 between every pair of distinct pitch classes, taking each
+// degree to the nearest semitone of 12-EDO.
+func (c IntervalContent) IntervalClassVector() [6]int {
+	var pitchClasses []int
+	for _, cents := range c.firstRow() {
+		pitchClass := int(math.Round(cents/100)) % 12
+		if !slices.Contains(pitchClasses, pitchClass) {
+			pitchClasses = append(pitchClasses, pitchClass)
+		}
+	}
+
+	var vector [6]int
+	for i := range pitchClasses {
+		for j := i + 1; j < len(pitchClasses); j++ {
+			interval := (pitchClasses[j] - pitchClasses[i] + 12) % 12
+			intervalClass := min(interval, 12-interval)
+			if intervalClass > 0 {
+				vector[intervalClass-1]++
+			}
+		}
+	}
+	return vector
+}
+
+// GenericIntervalSizes returns, for each generic interval of k steps (at index k-1), the distinct sizes in cents it
+// takes across the scale, smallest first.
+func (c IntervalContent) GenericIntervalSizes() [][]float64 {
+	degrees := len(c.cents)
+	var sizes [][]float64
+	for steps := 1; steps < degrees; steps++ {
+		var distinct []float64
+		for i := range degrees {
+			size := toDecimalPlaces(c.cents[i][(i+steps)%degrees], 2)
+			if !slices.Contains(distinct, size) {
+				distinct = append(distinct, size)
+			}
+		}
+		slices.Sort(distinct)
+		sizes = append(sizes, distinct)
+	}
+	return sizes
+}
+
+// NamedIntervalCounts counts how often each named interval occurs between ordered pairs of distinct degrees. It is
+// empty for tempered scales.
+func (c IntervalContent) NamedIntervalCounts() map[string]int {
+	counts := map[string]int{}
+	for i, row := range c.just {
+		for j, interval := range row {
+			if i == j {
+				continue
+			}
+			if name := interval.Name(); name != "" {
+				counts[name]++
+			}
+		}
+	}
+	return counts
+}
+
+// Count returns how many ordered pairs of distinct degrees are the given interval apart.
+func (c IntervalContent) Count(interval JustInterval) int {
+	count := 0
+	for i, row := range c.just {
+		for j, other := range row {
+			if i != j && other.IsEqualTo(interval) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (c IntervalContent) firstRow() []float64 {
+	if len(c.cents) == 0 {
+		return nil
+	}
+	return c.cents[0]
+}
@@ -0,0 +1,93 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldCountThePureFifthsInSymmetricFiveLimitChromaticScale(t *testing.T) {
+	// Given
+	content := New5LimitJustIntonationChromaticScale(Symmetric1).IntervalContent()
+
+	// When
+	fifths := content.Count(PerfectFifth())
+
+	// Then
+	assert.Equal(t, 9, fifths)
+	assert.Equal(t, 9, content.NamedIntervalCounts()[PerfectFifth().Name()])
+}
+
+func Test_ShouldReturnMatrixOfJustIntervalsBetweenDegrees(t *testing.T) {
+	// Given
+	content := NewPythagoreanScale().IntervalContent()
+
+	// When
+	matrix, exact := content.Matrix()
+
+	// Then
+	assert.True(t, exact)
+	assert.Equal(t, 13, len(matrix))
+	assert.True(t, matrix[0][0].IsEqualTo(JustInterval{numerator: 1, denominator: 1}))
+	assert.True(t, matrix[0][8].IsEqualTo(PerfectFifth()))
+	assert.True(t, matrix[8][0].IsEqualTo(PerfectFourth()))
+	assert.InDelta(t, 498.04, content.CentsMatrix()[8][0], 0.01)
+}
+
+func Test_ShouldReturnOnlyCentsMatrixForTemperedScale(t *testing.T) {
+	// Given
+	content := NewEqualTemperamentScale(12).IntervalContent()
+
+	// When
+	matrix, exact := content.Matrix()
+
+	// Then
+	assert.False(t, exact)
+	assert.Nil(t, matrix)
+	assert.InDelta(t, 300.0, content.CentsMatrix()[9][0], 0.001)
+	assert.Empty(t, content.NamedIntervalCounts())
+}
+
+func Test_ShouldReturnIntervalClassVectorOfDiatonicScale(t *testing.T) {
+	// Given
+	content := NewIntenseDiatonicScale(IonianMode).IntervalContent()
+
+	// When
+	vector := content.IntervalClassVector()
+
+	// Then
+	assert.Equal(t, [6]int{2, 5, 4, 3, 6, 1}, vector)
+}
+
+func Test_ShouldReturnIntervalClassVectorOfWholeToneScale(t *testing.T) {
+	// Given
+	content := NewEqualTemperamentScale(6).IntervalContent()
+
+	// When
+	vector := content.IntervalClassVector()
+
+	// Then
+	assert.Equal(t, [6]int{0, 6, 0, 6, 0, 3}, vector)
+}
+
+func Test_ShouldReturnDistinctSizesOfEachGenericInterval(t *testing.T) {
+	// Given
+	content := NewIntenseDiatonicScale(IonianMode).IntervalContent()
+
+	// When
+	sizes := content.GenericIntervalSizes()
+
+	// Then
+	assert.Equal(t, 6, len(sizes))
+	assert.Equal(t, []float64{111.73, 182.4, 203.91}, sizes[0])
+	assert.Equal(t, []float64{294.13, 315.64, 386.31}, sizes[1])
+	assert.Equal(t, []float64{498.04, 519.55, 590.22}, sizes[2])
+}
+
+func Test_ShouldReturnOneSizeForEachGenericIntervalOfEqualTemperament(t *testing.T) {
+	// When
+	sizes := NewEqualTemperamentScale(5).IntervalContent().GenericIntervalSizes()
+
+	// Then
+	assert.Equal(t, [][]float64{{240}, {480}, {720}, {960}}, sizes)
+}
@@ -255,6 +255,32 @@ func (i JustInterval) Diff(other JustInterval) JustInterval {
 	return i.Subtract(other)
 }
 
+// primeExponents returns the interval as a monzo: the exponent of each prime in its numerator less that in its denominator.
+func (i JustInterval) primeExponents() map[uint]int {
+	exponents := primeFactorsOf(i.numerator)
+	for prime, exponent := range primeFactorsOf(i.denominator) {
+		exponents[prime] -= exponent
+		if exponents[prime] == 0 {
+			delete(exponents, prime)
+		}
+	}
+	return exponents
+}
+
+func primeFactorsOf(n uint) map[uint]int {
+	factors := map[uint]int{}
+	for prime := uint(2); prime*prime <= n; prime++ {
+		for n%prime == 0 {
+			factors[prime]++
+			n /= prime
+		}
+	}
+	if n > 1 {
+		factors[n]++
+	}
+	return factors
+}
+
 func IntervalsFromIntegers(integers [][]uint) []JustInterval {
 	var intervals []JustInterval
 	for _, pair := range integers {
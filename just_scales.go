@@ -1,9 +1,6 @@
 package music
 
-import (
-	"fmt"
-	"slices"
-)
+import "fmt"
 
 type JustScale struct {
 	system      string
@@ -110,6 +107,14 @@ func (s JustScale) Intervals() []JustInterval {
 	return s.algorithm()
 }
 
+func (s JustScale) Cents() []float64 {
+	var cents []float64
+	for _, interval := range s.Intervals() {
+		cents = append(cents, interval.ToCents())
+	}
+	return cents
+}
+
 type computeJustIntervalsFn func() []JustInterval
 
 func computePtolemeicIntenseDiatonicScale(mode MusicalMode) []JustInterval {
@@ -127,33 +132,11 @@ func computePtolemeicIntenseDiatonicScale(mode MusicalMode) []JustInterval {
 		LocrianMode:    {diatonicSemitone, greaterMajorSecond, lesserMajorSecond, diatonicSemitone, greaterMajorSecond, lesserMajorSecond, greaterMajorSecond},
 	}
 
-	var interval = Unison()
-	var intervals = []JustInterval{interval}
-
-	for _, v := range intervalMap[mode] {
-		interval = JustInterval{numerator: interval.numerator * v.numerator, denominator: interval.denominator * v.denominator}.Simplify()
-		intervals = append(intervals, interval)
-	}
-
-	return intervals
+	return intervalsFromSteps(intervalMap[mode])
 }
 
 func computePythagoreanIntervals() []JustInterval {
-	var fifthsFromTonicToCompute = 6
-	var intervals []JustInterval
-	for i := -fifthsFromTonicToCompute; i <= fifthsFromTonicToCompute; i++ {
-		if i < 0 {
-			intervals = append(intervals, PerfectFifth().ToPowerOf(i).Reciprocal().OctaveReduce())
-		} else {
-			intervals = append(intervals, PerfectFifth().ToPowerOf(i).OctaveReduce())
-		}
-	}
-
-	intervals = append(intervals, Octave())
-	slices.SortFunc(intervals, func(i, j JustInterval) int {
-		return i.sortWith(j)
-	})
-	return intervals
+	return computeChainOfFifths(-6, 6)
 }
 
 func compute5LimitPythagoreanIntervals() []JustInterval {
@@ -404,3 +404,15 @@ func Test_ShouldReturnSazScaleWithExpectedScaleDegrees(t *testing.T) {
 	assert.Equal(t, JustInterval{numerator: 64, denominator: 33}, intervals[16])
 	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[17])
 }
+
+func Test_ShouldReturnCentsOfEachScaleDegree(t *testing.T) {
+	// When
+	cents := NewIntenseDiatonicScale(IonianMode).Cents()
+
+	// Then
+	assert.Equal(t, 8, len(cents))
+	assert.InDelta(t, 0.0, cents[0], 1e-9)
+	assert.InDelta(t, 386.31, cents[2], 0.01)
+	assert.InDelta(t, 701.96, cents[4], 0.01)
+	assert.InDelta(t, 1200.0, cents[7], 1e-9)
+}
@@ -0,0 +1,253 @@
+package music
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"slices"
+	"strings"
+)
+
+// LatticeAxis the direction in which one step up by a prime moves a point of the lattice, with screen y pointing down.
+type LatticeAxis struct {
+	Prime uint
+	X, Y  float64
+}
+
+// DefaultLatticeAxes 3 horizontal, 5 vertical and the higher primes on oblique axes.
+func DefaultLatticeAxes() []LatticeAxis {
+	return []LatticeAxis{
+		{Prime: 3, X: 1, Y: 0},
+		{Prime: 5, X: 0, Y: -1},
+		{Prime: 7, X: 0.4, Y: -0.4},
+		{Prime: 11, X: -0.4, Y: -0.4},
+		{Prime: 13, X: 0.4, Y: 0.4},
+		{Prime: 17, X: -0.4, Y: 0.4},
+		{Prime: 19, X: 0.25, Y: -0.65},
+		{Prime: 23, X: -0.25, Y: -0.65},
+	}
+}
+
+// LatticePoint an interval placed on the lattice by its exponent of each axis prime, ignoring octaves.
+type LatticePoint struct {
+	Interval    JustInterval
+	Coordinates []int
+	X, Y        float64
+}
+
+// LatticeEdge joins two points a step apart along the axis of one prime.
+type LatticeEdge struct {
+	From, To int
+	Prime    uint
+}
+
+// LatticeComma joins two points whose intervals differ by a highlighted comma.
+type LatticeComma struct {
+	From, To int
+	Comma    JustInterval
+}
+
+// LatticeLabel how points are labelled when drawn.
+type LatticeLabel string
+
+const (
+	// LabelByName labels a point with the name of its interval, or its ratio when it has no name.
+	LabelByName LatticeLabel = "name"
+	// LabelByRatio labels a point with its ratio.
+	LabelByRatio LatticeLabel = "ratio"
+)
+
+// Lattice a tonal lattice in the manner of Euler and Fokker, with each prime on its own axis and octaves equivalent.
+type Lattice struct {
+	axes    []LatticeAxis
+	points  []LatticePoint
+	edges   []LatticeEdge
+	commas  []LatticeComma
+	chord   []int
+	spacing float64
+}
+
+// NewLattice places the intervals on the given axes, or on the default axes when none are given, keeping the first
+// of any intervals an octave apart.
+func NewLattice(intervals []JustInterval, axes ...LatticeAxis) (Lattice, error) {
+	if len(axes) == 0 {
+		axes = DefaultLatticeAxes()
+	}
+	lattice := Lattice{axes: axes, spacing: 90}
+	for _, interval := range intervals {
+		point, err := lattice.place(interval)
+		if err != nil {
+			return Lattice{}, err
+		}
+		if lattice.indexOf(point.Coordinates) < 0 {
+			lattice.points = append(lattice.points, point)
+		}
+	}
+	lattice.connectNeighbours()
+	return lattice, nil
+}
+
+// NewScaleLattice places every degree of a just scale.
+func NewScaleLattice(scale JustScale, axes ...LatticeAxis) (Lattice, error) {
+	return NewLattice(scale.Intervals(), axes...)
+}
+
+func (l Lattice) place(interval JustInterval) (LatticePoint, error) {
+	point := LatticePoint{Interval: interval, Coordinates: make([]int, len(l.axes))}
+	for prime, exponent := range interval.primeExponents() {
+		if prime == 2 {
+			continue
+		}
+		axis := slices.IndexFunc(l.axes, func(a LatticeAxis) bool { return a.Prime == prime })
+		if axis < 0 {
+			return LatticePoint{}, fmt.Errorf("cannot place %s on the lattice as it has no axis for the prime %d", interval, prime)
+		}
+		point.Coordinates[axis] = exponent
+	}
+	for axis, exponent := range point.Coordinates {
+		point.X += float64(exponent) * l.axes[axis].X
+		point.Y += float64(exponent) * l.axes[axis].Y
+	}
+	return point, nil
+}
+
+// connectNeighbours joins every pair of points a single step apart on one axis.
+func (l *Lattice) connectNeighbours() {
+	l.edges = nil
+	for i, from := range l.points {
+		for j := i + 1; j < len(l.points); j++ {
+			if axis, ok := singleStepBetween(from.Coordinates, l.points[j].Coordinates); ok {
+				l.edges = append(l.edges, LatticeEdge{From: i, To: j, Prime: l.axes[axis].Prime})
+			}
+		}
+	}
+}
+
+func (l Lattice) Points() []LatticePoint {
+	return l.points
+}
+
+func (l Lattice) Edges() []LatticeEdge {
+	return l.edges
+}
+
+func (l Lattice) Commas() []LatticeComma {
+	return l.commas
+}
+
+// HighlightCommas marks every pair of points whose intervals differ by one of the commas, in any octave.
+func (l Lattice) HighlightCommas(commas ...JustInterval) Lattice {
+	l.commas = nil
+	for i, from := range l.points {
+		for j := i + 1; j < len(l.points); j++ {
+			difference := l.points[j].Interval.Subtract(from.Interval).OctaveReduce().Simplify()
+			for _, comma := range commas {
+				reduced := comma.OctaveReduce().Simplify()
+				if difference.IsEqualTo(reduced) || difference.IsEqualTo(reduced.Reciprocal().OctaveReduce().Simplify()) {
+					l.commas = append(l.commas, LatticeComma{From: i, To: j, Comma: comma})
+				}
+			}
+		}
+	}
+	return l
+}
+
+// HighlightChord marks the points that are notes of the chord in any octave, adding any that are missing and joining
+// them to their neighbours.
+func (l Lattice) HighlightChord(chord JustChord) (Lattice, error) {
+	highlighted := l
+	highlighted.points = slices.Clone(l.points)
+	highlighted.chord = nil
+	for _, note := range chord.Notes() {
+		point, err := highlighted.place(note)
+		if err != nil {
+			return Lattice{}, err
+		}
+		index := highlighted.indexOf(point.Coordinates)
+		if index < 0 {
+			highlighted.points = append(highlighted.points, point)
+			index = len(highlighted.points) - 1
+		}
+		if !slices.Contains(highlighted.chord, index) {
+			highlighted.chord = append(highlighted.chord, index)
+		}
+	}
+	slices.Sort(highlighted.chord)
+	if len(highlighted.points) > len(l.points) {
+		highlighted.connectNeighbours()
+	}
+	return highlighted, nil
+}
+
+// ChordPoints the indices of the points marked by HighlightChord.
+func (l Lattice) ChordPoints() []int {
+	return l.chord
+}
+
+// SVG draws the lattice with each point labelled as asked, consonant neighbours joined by solid lines, highlighted
+// commas by dashed red lines and the points of a highlighted chord filled.
+func (l Lattice) SVG(label LatticeLabel) string {
+	if len(l.points) == 0 {
+		return `<svg xmlns="http://www.w3.org/2000/svg" width="0" height="0"></svg>` + "\n"
+	}
+	const margin = 60.0
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, point := range l.points {
+		minX, maxX = min(minX, point.X), max(maxX, point.X)
+		minY, maxY = min(minY, point.Y), max(maxY, point.Y)
+	}
+	x := func(point LatticePoint) float64 { return margin + (point.X-minX)*l.spacing }
+	y := func(point LatticePoint) float64 { return margin + (point.Y-minY)*l.spacing }
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" font-family="sans-serif" font-size="11">`+"\n",
+		2*margin+(maxX-minX)*l.spacing, 2*margin+(maxY-minY)*l.spacing)
+	for _, edge := range l.edges {
+		from, to := l.points[edge.From], l.points[edge.To]
+		fmt.Fprintf(&svg, `  <line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="black" stroke-width="1.5" data-prime="%d"/>`+"\n",
+			x(from), y(from), x(to), y(to), edge.Prime)
+	}
+	for _, comma := range l.commas {
+		from, to := l.points[comma.From], l.points[comma.To]
+		fmt.Fprintf(&svg, `  <line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="red" stroke-width="1.5" stroke-dasharray="4 3" data-comma="%s"/>`+"\n",
+			x(from), y(from), x(to), y(to), comma.Comma)
+	}
+	for i, point := range l.points {
+		fill := "white"
+		if slices.Contains(l.chord, i) {
+			fill = "gold"
+		}
+		fmt.Fprintf(&svg, `  <circle cx="%.1f" cy="%.1f" r="6" fill="%s" stroke="black"/>`+"\n", x(point), y(point), fill)
+		fmt.Fprintf(&svg, `  <text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`+"\n", x(point), y(point)-10, html.EscapeString(labelOf(point.Interval, label)))
+	}
+	svg.WriteString("</svg>\n")
+	return svg.String()
+}
+
+func labelOf(interval JustInterval, label LatticeLabel) string {
+	ratio := fmt.Sprintf("%d/%d", interval.numerator, interval.denominator)
+	if name := interval.Name(); label == LabelByName && name != "" {
+		return name
+	}
+	return ratio
+}
+
+func (l Lattice) indexOf(coordinates []int) int {
+	return slices.IndexFunc(l.points, func(p LatticePoint) bool { return slices.Equal(p.Coordinates, coordinates) })
+}
+
+// singleStepBetween returns the axis along which two points are a single step apart, if they differ on only one.
+func singleStepBetween(a, b []int) (int, bool) {
+	axis := -1
+	for i := range a {
+		switch difference := a[i] - b[i]; {
+		case difference == 0:
+			continue
+		case axis >= 0 || (difference != 1 && difference != -1):
+			return 0, false
+		default:
+			axis = i
+		}
+	}
+	return axis, axis >= 0
+}
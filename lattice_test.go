@@ -0,0 +1,94 @@
+package music
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldPlaceIntervalsOnPrimeAxes(t *testing.T) {
+	// When
+	lattice, err := NewLattice(IntervalsFromIntegers([][]uint{{1, 1}, {3, 2}, {5, 4}, {7, 4}, {15, 8}, {2, 1}}))
+
+	// Then
+	assert.Nil(t, err)
+	points := lattice.Points()
+	assert.Equal(t, 5, len(points))
+	assert.Equal(t, []int{0, 0, 0, 0, 0, 0, 0, 0}, points[0].Coordinates)
+	assert.Equal(t, []int{1, 0, 0, 0, 0, 0, 0, 0}, points[1].Coordinates)
+	assert.Equal(t, []int{0, 1, 0, 0, 0, 0, 0, 0}, points[2].Coordinates)
+	assert.Equal(t, []int{1, 1, 0, 0, 0, 0, 0, 0}, points[4].Coordinates)
+	assert.Equal(t, 1.0, points[1].X)
+	assert.Equal(t, -1.0, points[2].Y)
+	assert.Equal(t, []LatticeEdge{{From: 0, To: 1, Prime: 3}, {From: 0, To: 2, Prime: 5}, {From: 0, To: 3, Prime: 7}, {From: 1, To: 4, Prime: 5}, {From: 2, To: 4, Prime: 3}}, lattice.Edges())
+}
+
+func Test_ShouldReturnErrorWhenPrimeHasNoAxis(t *testing.T) {
+	// When
+	_, err := NewLattice([]JustInterval{NewInterval(11, 8)}, LatticeAxis{Prime: 3, X: 1}, LatticeAxis{Prime: 5, Y: -1})
+
+	// Then
+	assert.EqualError(t, err, "cannot place 11:8 on the lattice as it has no axis for the prime 11")
+}
+
+func Test_ShouldDrawSevenLimitScaleAsSVG(t *testing.T) {
+	// Given
+	lattice, err := NewScaleLattice(New7LimitJustIntonationChromaticScale())
+	assert.Nil(t, err)
+
+	// When
+	svg := lattice.SVG(LabelByName)
+
+	// Then
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`))
+	assert.Equal(t, len(lattice.Points()), strings.Count(svg, "<circle"))
+	assert.Equal(t, len(lattice.Edges()), strings.Count(svg, "<line"))
+	assert.Contains(t, svg, ">Perfect Fifth</text>")
+	assert.Contains(t, svg, `data-prime="7"`)
+}
+
+func Test_ShouldDrawSazScaleWithElevenAndSeventeenAxes(t *testing.T) {
+	// Given
+	lattice, err := NewScaleLattice(NewSazScale())
+	assert.Nil(t, err)
+
+	// When
+	svg := lattice.SVG(LabelByRatio)
+
+	// Then
+	assert.Equal(t, 17, len(lattice.Points()))
+	assert.Contains(t, svg, ">18/17</text>")
+	assert.Contains(t, svg, ">12/11</text>")
+	assert.Contains(t, svg, `data-prime="17"`)
+}
+
+func Test_ShouldHighlightSyntonicCommasInFiveLimitScale(t *testing.T) {
+	// Given
+	lattice, _ := NewLattice(IntervalsFromIntegers([][]uint{{1, 1}, {10, 9}, {9, 8}, {5, 3}, {27, 16}}))
+
+	// When
+	highlighted := lattice.HighlightCommas(SyntonicComma())
+
+	// Then
+	assert.Equal(t, []LatticeComma{{From: 1, To: 2, Comma: SyntonicComma()}, {From: 3, To: 4, Comma: SyntonicComma()}}, highlighted.Commas())
+	assert.Empty(t, lattice.Commas())
+	assert.Equal(t, 2, strings.Count(highlighted.SVG(LabelByRatio), "stroke-dasharray"))
+}
+
+func Test_ShouldHighlightChordAddingMissingNotes(t *testing.T) {
+	// Given
+	lattice, _ := NewScaleLattice(NewIntenseDiatonicScale(IonianMode))
+
+	// When
+	highlighted, err := lattice.HighlightChord(NewOtonalChord(4, 5, 6, 7))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 7, len(lattice.Points()))
+	assert.Equal(t, 8, len(highlighted.Points()))
+	assert.Equal(t, []int{0, 2, 4, 7}, highlighted.ChordPoints())
+	assert.Contains(t, highlighted.Edges(), LatticeEdge{From: 0, To: 7, Prime: 7})
+	assert.Equal(t, len(lattice.Edges())+1, len(highlighted.Edges()))
+	assert.Equal(t, 4, strings.Count(highlighted.SVG(LabelByRatio), `fill="gold"`))
+}
@@ -0,0 +1,300 @@
+package music
+
+import (
+	"fmt"
+	"math"
+)
+
+// Jins a trichord, tetrachord or pentachord characterised by its steps in cents.
+type Jins struct {
+	name  string
+	steps []float64
+}
+
+type SeyirDirection string
+
+const (
+	AscendingSeyir           SeyirDirection = "ascending"
+	DescendingSeyir          SeyirDirection = "descending"
+	AscendingDescendingSeyir SeyirDirection = "ascending-descending"
+)
+
+func (d SeyirDirection) String() string {
+	return string(d)
+}
+
+// Maqam a lower jins on the tonic and an upper jins on the ghammaz, the degree of the lower jins at which it begins,
+// optionally continued by a further jins from the last note of the upper one where the two do not fill the octave.
+type Maqam struct {
+	name      string
+	lower     Jins
+	ghammaz   int
+	upper     Jins
+	extension Jins
+	seyir     SeyirDirection
+}
+
+func NewJins(name string, stepsInCents ...float64) Jins {
+	return Jins{name: name, steps: stepsInCents}
+}
+
+// NewJinsInHoldrianCommas defines a jins in steps of a 53rd of an octave, as Turkish theory does.
+func NewJinsInHoldrianCommas(name string, commas ...int) Jins {
+	var steps []float64
+	for _, c := range commas {
+		steps = append(steps, float64(c)*centsInOctave/53)
+	}
+	return Jins{name: name, steps: steps}
+}
+
+func (j Jins) Name() string {
+	return j.name
+}
+
+func (j Jins) Steps() []float64 {
+	return j.steps
+}
+
+// Cents returns the notes of the jins in cents above its first note, which is 0.
+func (j Jins) Cents() []float64 {
+	var cents = []float64{0}
+	for _, step := range j.steps {
+		cents = append(cents, cents[len(cents)-1]+step)
+	}
+	return cents
+}
+
+func (j Jins) Span() float64 {
+	cents := j.Cents()
+	return cents[len(cents)-1]
+}
+
+// Size is the number of notes in the jins: 3 for a trichord, 4 for a tetrachord and 5 for a pentachord.
+func (j Jins) Size() int {
+	return len(j.steps) + 1
+}
+
+func (j Jins) String() string {
+	return fmt.Sprintf("Jins %s", j.name)
+}
+
+// The ajnas below follow the common Arabic quarter-tone (24-EDO) approximations.
+
+func JinsRast() Jins {
+	return NewJins("Rast", 200, 150, 150, 200)
+}
+
+func JinsBayati() Jins {
+	return NewJins("Bayati", 150, 150, 200)
+}
+
+func JinsHijaz() Jins {
+	return NewJins("Hijaz", 100, 300, 100)
+}
+
+func JinsSaba() Jins {
+	return NewJins("Saba", 150, 150, 100)
+}
+
+func JinsSikah() Jins {
+	return NewJins("Sikah", 150, 200)
+}
+
+func JinsNahawand() Jins {
+	return NewJins("Nahawand", 200, 100, 200, 200)
+}
+
+func JinsKurd() Jins {
+	return NewJins("Kurd", 100, 200, 200)
+}
+
+func JinsAjam() Jins {
+	return NewJins("Ajam", 200, 200, 100, 200)
+}
+
+func JinsNikriz() Jins {
+	return NewJins("Nikriz", 200, 100, 300, 100)
+}
+
+func Ajnas() []Jins {
+	return []Jins{JinsRast(), JinsBayati(), JinsHijaz(), JinsSaba(), JinsSikah(), JinsNahawand(), JinsKurd(), JinsAjam(), JinsNikriz()}
+}
+
+// NewMaqam fails unless the ghammaz, counted from 0 on the tonic, is one of the notes of the lower jins above the tonic.
+func NewMaqam(name string, lower Jins, ghammaz int, upper Jins, seyir SeyirDirection) (Maqam, error) {
+	if ghammaz < 1 || ghammaz >= lower.Size() {
+		return Maqam{}, fmt.Errorf("maqam %s needs its ghammaz on one of degrees 1 to %d of %s but was given %d", name, lower.Size()-1, lower, ghammaz)
+	}
+	return maqamOf(name, lower, ghammaz, upper, seyir), nil
+}
+
+func maqamOf(name string, lower Jins, ghammaz int, upper Jins, seyir SeyirDirection) Maqam {
+	return Maqam{name: name, lower: lower, ghammaz: ghammaz, upper: upper, seyir: seyir}
+}
+
+func MaqamRast() Maqam {
+	return maqamOf("Rast", JinsRast(), 4, JinsRast(), AscendingSeyir)
+}
+
+func MaqamBayati() Maqam {
+	return maqamOf("Bayati", JinsBayati(), 3, JinsNahawand(), AscendingSeyir)
+}
+
+func MaqamHijaz() Maqam {
+	return maqamOf("Hijaz", JinsHijaz(), 3, JinsRast(), AscendingDescendingSeyir)
+}
+
+// MaqamSaba Saba's diminished fourth leaves its upper Hijaz on the third degree ending a sixth above the tonic, so
+// the octave is reached through Ajam on the sixth.
+func MaqamSaba() Maqam {
+	return maqamOf("Saba", JinsSaba(), 2, JinsHijaz(), AscendingSeyir).ExtendedBy(JinsAjam())
+}
+
+func MaqamSikah() Maqam {
+	return maqamOf("Sikah", JinsSikah(), 2, JinsRast(), AscendingSeyir)
+}
+
+func MaqamNahawand() Maqam {
+	return maqamOf("Nahawand", JinsNahawand(), 4, JinsHijaz(), DescendingSeyir)
+}
+
+func MaqamKurd() Maqam {
+	return maqamOf("Kurd", JinsKurd(), 3, JinsNahawand(), DescendingSeyir)
+}
+
+func MaqamAjam() Maqam {
+	return maqamOf("Ajam", JinsAjam(), 4, JinsAjam(), DescendingSeyir)
+}
+
+func Maqamat() []Maqam {
+	return []Maqam{MaqamRast(), MaqamBayati(), MaqamHijaz(), MaqamSaba(), MaqamSikah(), MaqamNahawand(), MaqamKurd(), MaqamAjam()}
+}
+
+func (m Maqam) Name() string {
+	return m.name
+}
+
+func (m Maqam) Lower() Jins {
+	return m.lower
+}
+
+func (m Maqam) Upper() Jins {
+	return m.upper
+}
+
+func (m Maqam) Ghammaz() int {
+	return m.ghammaz
+}
+
+// ExtendedBy continues the maqam with a jins on the last note of its upper jins.
+func (m Maqam) ExtendedBy(jins Jins) Maqam {
+	m.extension = jins
+	return m
+}
+
+func (m Maqam) Extension() Jins {
+	return m.extension
+}
+
+func (m Maqam) Seyir() SeyirDirection {
+	return m.seyir
+}
+
+// Cents returns the notes of the maqam over one octave in ascending order, ending on the octave. Notes of the upper
+// jins or its extension beyond the octave are left out.
+func (m Maqam) Cents() []float64 {
+	lower := m.lower.Cents()
+	ghammaz := lower[m.ghammaz]
+	cents := append([]float64{}, lower[:m.ghammaz]...)
+	for _, c := range m.upper.Cents() {
+		if ghammaz+c < centsInOctave {
+			cents = append(cents, ghammaz+c)
+		}
+	}
+	extensionStart := ghammaz + m.upper.Span()
+	for _, c := range m.extension.Cents()[1:] {
+		if extensionStart+c < centsInOctave {
+			cents = append(cents, extensionStart+c)
+		}
+	}
+	return append(cents, centsInOctave)
+}
+
+// SeyirPath returns the notes of the maqam in the order its seyir presents them.
+func (m Maqam) SeyirPath() []float64 {
+	ascending := m.Cents()
+	var descending []float64
+	for i := len(ascending) - 1; i >= 0; i-- {
+		descending = append(descending, ascending[i])
+	}
+	switch m.seyir {
+	case DescendingSeyir:
+		return descending
+	case AscendingDescendingSeyir:
+		return append(ascending, descending[1:]...)
+	}
+	return ascending
+}
+
+func (m Maqam) String() string {
+	return fmt.Sprintf("Maqam %s (%s, ghammaz on degree %d, %s)", m.name, m.lower, m.ghammaz+1, m.upper)
+}
+
+// InJustScale realises the maqam on the 1/1 of a scale, such as NewSazScale, using its nearest degree to each note.
+func (m Maqam) InJustScale(scale JustScale) (JustScale, error) {
+	degrees, err := nearestDegreesOf(m.Cents(), scale.Cents())
+	if err != nil {
+		return JustScale{}, fmt.Errorf("cannot realise maqam %s in %s: %w", m.name, scale.system, err)
+	}
+	return JustScale{
+		system:      scale.system,
+		description: fmt.Sprintf("%s Realised as Maqam %s.", scale.description, m.name),
+		algorithm: func() []JustInterval {
+			intervals := append(withoutOctave(scale.Intervals()), Octave())
+			var realised []JustInterval
+			for _, degree := range degrees {
+				realised = append(realised, intervals[degree])
+			}
+			return realised
+		},
+	}, nil
+}
+
+// InTemperedScale realises the maqam in a scale such as NewEqualTemperamentScale(24) or NewEqualTemperamentScale(53).
+func (m Maqam) InTemperedScale(scale TemperedScale) (TemperedScale, error) {
+	degrees, err := nearestDegreesOf(m.Cents(), scale.Cents())
+	if err != nil {
+		return TemperedScale{}, fmt.Errorf("cannot realise maqam %s in %s: %w", m.name, scale.system, err)
+	}
+	return TemperedScale{
+		system:      scale.system,
+		description: fmt.Sprintf("%s Realised as Maqam %s.", scale.description, m.name),
+		algorithm: func() []TemperedInterval {
+			intervals := append(withoutTemperedOctave(scale.Intervals()), 2.0)
+			var realised []TemperedInterval
+			for _, degree := range degrees {
+				realised = append(realised, intervals[degree])
+			}
+			return realised
+		},
+	}, nil
+}
+
+// nearestDegreesOf finds the nearest degree of the scale to each target, failing if two targets share a degree.
+func nearestDegreesOf(targets []float64, scaleCents []float64) ([]int, error) {
+	scaleCents = append(centsWithoutOctave(scaleCents), centsInOctave)
+	var degrees []int
+	for _, target := range targets {
+		nearest := 0
+		for degree, c := range scaleCents {
+			if math.Abs(c-target) < math.Abs(scaleCents[nearest]-target) {
+				nearest = degree
+			}
+		}
+		if len(degrees) > 0 && degrees[len(degrees)-1] == nearest {
+			return nil, fmt.Errorf("notes at %.1f and %.1f cents fall on the same degree", targets[len(degrees)-1], target)
+		}
+		degrees = append(degrees, nearest)
+	}
+	return degrees, nil
+}
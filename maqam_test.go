@@ -0,0 +1,115 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldDescribeJinsHijaz(t *testing.T) {
+	// Given
+	jins := JinsHijaz()
+
+	// Then
+	assert.Equal(t, "Hijaz", jins.Name())
+	assert.Equal(t, 4, jins.Size())
+	assert.Equal(t, []float64{0, 100, 400, 500}, jins.Cents())
+	assert.Equal(t, 500.0, jins.Span())
+	assert.Equal(t, "Jins Hijaz", jins.String())
+}
+
+func Test_ShouldDefineJinsInHoldrianCommas(t *testing.T) {
+	// When
+	jins := NewJinsInHoldrianCommas("Uşşak", 8, 5, 9)
+
+	// Then
+	assert.InDelta(t, 498.11, jins.Span(), 0.01)
+	assert.InDelta(t, 181.13, jins.Steps()[0], 0.01)
+}
+
+func Test_ShouldReturnNotesOfMaqamRast(t *testing.T) {
+	// Given
+	maqam := MaqamRast()
+
+	// Then
+	assert.Equal(t, []float64{0, 200, 350, 500, 700, 900, 1050, 1200}, maqam.Cents())
+	assert.Equal(t, 4, maqam.Ghammaz())
+	assert.Equal(t, AscendingSeyir, maqam.Seyir())
+	assert.Equal(t, "Maqam Rast (Jins Rast, ghammaz on degree 5, Jins Rast)", maqam.String())
+}
+
+func Test_ShouldReturnEveryMaqamSpanningAnOctave(t *testing.T) {
+	for _, maqam := range Maqamat() {
+		t.Run(maqam.Name(), func(t *testing.T) {
+			cents := maqam.Cents()
+			assert.Equal(t, 0.0, cents[0])
+			assert.Equal(t, 1200.0, cents[len(cents)-1])
+			for i := 1; i < len(cents); i++ {
+				assert.Greater(t, cents[i], cents[i-1])
+				assert.LessOrEqual(t, cents[i]-cents[i-1], 300.0, "step from %.0f to %.0f cents is wider than an augmented second", cents[i-1], cents[i])
+			}
+		})
+	}
+}
+
+func Test_ShouldReachOctaveOfMaqamSabaThroughAjamOnSixth(t *testing.T) {
+	// Then
+	assert.Equal(t, []float64{0, 150, 300, 400, 700, 800, 1000, 1200}, MaqamSaba().Cents())
+	assert.Equal(t, JinsAjam(), MaqamSaba().Extension())
+}
+
+func Test_ShouldReturnSeyirPathOfDescendingMaqam(t *testing.T) {
+	// Then
+	assert.Equal(t, []float64{1200, 1000, 800, 700, 500, 300, 100, 0}, MaqamKurd().SeyirPath())
+	assert.Equal(t, []float64{0, 100, 400, 500, 700, 850, 1000, 1200, 1000, 850, 700, 500, 400, 100, 0}, MaqamHijaz().SeyirPath())
+}
+
+func Test_ShouldRealiseMaqamBayatiInSazScale(t *testing.T) {
+	// When
+	scale, err := MaqamBayati().InJustScale(NewSazScale())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "Saz", scale.System())
+	assert.Equal(t, "Turkish Saz tuning ratios. Realised as Maqam Bayati.", scale.Description())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {12, 11}, {81, 68}, {4, 3}, {3, 2}, {27, 17}, {16, 9}, {2, 1}}), scale.Intervals())
+}
+
+func Test_ShouldRealiseMaqamSikahInQuarterToneAndHoldrianCommaEqualTemperaments(t *testing.T) {
+	// When
+	quarterTones, err := MaqamSikah().InTemperedScale(NewEqualTemperamentScale(24))
+	assert.NoError(t, err)
+	commas, err := MaqamSikah().InTemperedScale(NewEqualTemperamentScale(53))
+	assert.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []float64{0, 150, 350, 550, 700, 850, 1050, 1200}, quarterTones.Cents())
+	assert.Equal(t, []float64{0, 158.49, 339.62, 543.4, 701.89, 860.38, 1041.51, 1200}, commas.Cents())
+}
+
+func Test_ShouldNotRealiseMaqamWhenNotesShareDegree(t *testing.T) {
+	// When
+	_, err := MaqamSaba().InTemperedScale(NewEqualTemperamentScale(5))
+
+	// Then
+	assert.EqualError(t, err, "cannot realise maqam Saba in Equal Temperament: notes at 150.0 and 300.0 cents fall on the same degree")
+}
+
+func Test_ShouldBuildMaqamWithGhammazInsideLowerJins(t *testing.T) {
+	// When
+	maqam, err := NewMaqam("Bespoke", JinsSikah(), 2, JinsHijaz(), AscendingSeyir)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0, 150, 350, 450, 750, 850, 1200}, maqam.Cents())
+}
+
+func Test_ShouldRejectGhammazOutsideLowerJins(t *testing.T) {
+	// When
+	_, negative := NewMaqam("Bespoke", JinsKurd(), -1, JinsNahawand(), AscendingSeyir)
+	_, beyond := NewMaqam("Bespoke", JinsKurd(), 4, JinsNahawand(), AscendingSeyir)
+
+	// Then
+	assert.EqualError(t, negative, "maqam Bespoke needs its ghammaz on one of degrees 1 to 3 of Jins Kurd but was given -1")
+	assert.EqualError(t, beyond, "maqam Bespoke needs its ghammaz on one of degrees 1 to 3 of Jins Kurd but was given 4")
+}
@@ -1,5 +1,10 @@
 package music
 
+import (
+	"fmt"
+	"slices"
+)
+
 const (
 	LydianMode     MusicalMode = "Lydian"
 	IonianMode     MusicalMode = "Ionian"
@@ -8,18 +13,175 @@ const (
 	AeolianMode    MusicalMode = "Aeolian"
 	PhrygianMode   MusicalMode = "Phrygian"
 	LocrianMode    MusicalMode = "Locrian"
+
+	MelodicMinorMode    MusicalMode = "Melodic Minor"
+	DorianFlat2Mode     MusicalMode = "Dorian b2"
+	LydianAugmentedMode MusicalMode = "Lydian Augmented"
+	LydianDominantMode  MusicalMode = "Lydian Dominant"
+	MixolydianFlat6Mode MusicalMode = "Mixolydian b6"
+	LocrianNatural2Mode MusicalMode = "Locrian Natural 2"
+	AlteredMode         MusicalMode = "Altered"
+
+	HarmonicMinorMode     MusicalMode = "Harmonic Minor"
+	LocrianNatural6Mode   MusicalMode = "Locrian Natural 6"
+	IonianAugmentedMode   MusicalMode = "Ionian Augmented"
+	UkrainianDorianMode   MusicalMode = "Ukrainian Dorian"
+	PhrygianDominantMode  MusicalMode = "Phrygian Dominant"
+	LydianSharp2Mode      MusicalMode = "Lydian #2"
+	AlteredDiminishedMode MusicalMode = "Altered Diminished"
+
+	HarmonicMajorMode MusicalMode = "Harmonic Major"
+
+	DoubleHarmonicMajorMode MusicalMode = "Double Harmonic Major"
+	HungarianMinorMode      MusicalMode = "Hungarian Minor"
+
+	MajorPentatonicMode MusicalMode = "Major Pentatonic"
+	MinorPentatonicMode MusicalMode = "Minor Pentatonic"
+
+	BluesMode MusicalMode = "Blues"
+
+	WholeToneMode          MusicalMode = "Whole Tone"
+	OctatonicHalfWholeMode MusicalMode = "Octatonic Half-Whole"
+	OctatonicWholeHalfMode MusicalMode = "Octatonic Whole-Half"
+	MessiaenThirdMode      MusicalMode = "Messiaen Mode 3"
+	MessiaenFourthMode     MusicalMode = "Messiaen Mode 4"
+	MessiaenFifthMode      MusicalMode = "Messiaen Mode 5"
+	MessiaenSixthMode      MusicalMode = "Messiaen Mode 6"
+	MessiaenSeventhMode    MusicalMode = "Messiaen Mode 7"
+	MessiaenFirstMode                  = WholeToneMode
+	MessiaenSecondMode                 = OctatonicHalfWholeMode
 )
 
 type MusicalMode string
 
-func (m MusicalMode) IsDiatonic() bool {
-	switch m {
-	case LydianMode, IonianMode, MixolydianMode, DorianMode, AeolianMode, PhrygianMode, LocrianMode:
-		return true
-	default:
-		return false
+type ModeFamily string
+
+const (
+	DiatonicFamily             ModeFamily = "Diatonic"
+	MelodicMinorFamily         ModeFamily = "Melodic Minor"
+	HarmonicMinorFamily        ModeFamily = "Harmonic Minor"
+	HarmonicMajorFamily        ModeFamily = "Harmonic Major"
+	DoubleHarmonicFamily       ModeFamily = "Double Harmonic"
+	PentatonicFamily           ModeFamily = "Pentatonic"
+	BluesFamily                ModeFamily = "Blues"
+	LimitedTranspositionFamily ModeFamily = "Limited Transposition"
+	UnknownFamily              ModeFamily = "Unknown"
+)
+
+func (f ModeFamily) String() string {
+	return string(f)
+}
+
+type modeDefinition struct {
+	family ModeFamily
+	steps  []int
+}
+
+// modeDefinitions step patterns are in semitones of a twelve-note chromatic scale.
+var modeDefinitions = map[MusicalMode]modeDefinition{
+	IonianMode:     {DiatonicFamily, []int{2, 2, 1, 2, 2, 2, 1}},
+	DorianMode:     {DiatonicFamily, []int{2, 1, 2, 2, 2, 1, 2}},
+	PhrygianMode:   {DiatonicFamily, []int{1, 2, 2, 2, 1, 2, 2}},
+	LydianMode:     {DiatonicFamily, []int{2, 2, 2, 1, 2, 2, 1}},
+	MixolydianMode: {DiatonicFamily, []int{2, 2, 1, 2, 2, 1, 2}},
+	AeolianMode:    {DiatonicFamily, []int{2, 1, 2, 2, 1, 2, 2}},
+	LocrianMode:    {DiatonicFamily, []int{1, 2, 2, 1, 2, 2, 2}},
+
+	MelodicMinorMode:    {MelodicMinorFamily, []int{2, 1, 2, 2, 2, 2, 1}},
+	DorianFlat2Mode:     {MelodicMinorFamily, []int{1, 2, 2, 2, 2, 1, 2}},
+	LydianAugmentedMode: {MelodicMinorFamily, []int{2, 2, 2, 2, 1, 2, 1}},
+	LydianDominantMode:  {MelodicMinorFamily, []int{2, 2, 2, 1, 2, 1, 2}},
+	MixolydianFlat6Mode: {MelodicMinorFamily, []int{2, 2, 1, 2, 1, 2, 2}},
+	LocrianNatural2Mode: {MelodicMinorFamily, []int{2, 1, 2, 1, 2, 2, 2}},
+	AlteredMode:         {MelodicMinorFamily, []int{1, 2, 1, 2, 2, 2, 2}},
+
+	HarmonicMinorMode:     {HarmonicMinorFamily, []int{2, 1, 2, 2, 1, 3, 1}},
+	LocrianNatural6Mode:   {HarmonicMinorFamily, []int{1, 2, 2, 1, 3, 1, 2}},
+	IonianAugmentedMode:   {HarmonicMinorFamily, []int{2, 2, 1, 3, 1, 2, 1}},
+	UkrainianDorianMode:   {HarmonicMinorFamily, []int{2, 1, 3, 1, 2, 1, 2}},
+	PhrygianDominantMode:  {HarmonicMinorFamily, []int{1, 3, 1, 2, 1, 2, 2}},
+	LydianSharp2Mode:      {HarmonicMinorFamily, []int{3, 1, 2, 1, 2, 2, 1}},
+	AlteredDiminishedMode: {HarmonicMinorFamily, []int{1, 2, 1, 2, 2, 1, 3}},
+
+	HarmonicMajorMode: {HarmonicMajorFamily, []int{2, 2, 1, 2, 1, 3, 1}},
+
+	DoubleHarmonicMajorMode: {DoubleHarmonicFamily, []int{1, 3, 1, 2, 1, 3, 1}},
+	HungarianMinorMode:      {DoubleHarmonicFamily, []int{2, 1, 3, 1, 1, 3, 1}},
+
+	MajorPentatonicMode: {PentatonicFamily, []int{2, 2, 3, 2, 3}},
+	MinorPentatonicMode: {PentatonicFamily, []int{3, 2, 2, 3, 2}},
+
+	BluesMode: {BluesFamily, []int{3, 2, 1, 1, 3, 2}},
+
+	WholeToneMode:          {LimitedTranspositionFamily, []int{2, 2, 2, 2, 2, 2}},
+	OctatonicHalfWholeMode: {LimitedTranspositionFamily, []int{1, 2, 1, 2, 1, 2, 1, 2}},
+	OctatonicWholeHalfMode: {LimitedTranspositionFamily, []int{2, 1, 2, 1, 2, 1, 2, 1}},
+	MessiaenThirdMode:      {LimitedTranspositionFamily, []int{2, 1, 1, 2, 1, 1, 2, 1, 1}},
+	MessiaenFourthMode:     {LimitedTranspositionFamily, []int{1, 1, 3, 1, 1, 1, 3, 1}},
+	MessiaenFifthMode:      {LimitedTranspositionFamily, []int{1, 4, 1, 1, 4, 1}},
+	MessiaenSixthMode:      {LimitedTranspositionFamily, []int{2, 2, 1, 1, 2, 2, 1, 1}},
+	MessiaenSeventhMode:    {LimitedTranspositionFamily, []int{1, 1, 1, 2, 1, 1, 1, 1, 2, 1}},
+}
+
+// Modes returns every mode in the catalogue, grouped by family.
+func Modes() []MusicalMode {
+	return []MusicalMode{
+		IonianMode, DorianMode, PhrygianMode, LydianMode, MixolydianMode, AeolianMode, LocrianMode,
+		MelodicMinorMode, DorianFlat2Mode, LydianAugmentedMode, LydianDominantMode, MixolydianFlat6Mode, LocrianNatural2Mode, AlteredMode,
+		HarmonicMinorMode, LocrianNatural6Mode, IonianAugmentedMode, UkrainianDorianMode, PhrygianDominantMode, LydianSharp2Mode, AlteredDiminishedMode,
+		HarmonicMajorMode,
+		DoubleHarmonicMajorMode, HungarianMinorMode,
+		MajorPentatonicMode, MinorPentatonicMode,
+		BluesMode,
+		WholeToneMode, OctatonicHalfWholeMode, OctatonicWholeHalfMode, MessiaenThirdMode, MessiaenFourthMode, MessiaenFifthMode, MessiaenSixthMode, MessiaenSeventhMode,
+	}
+}
+
+func (m MusicalMode) Family() ModeFamily {
+	if definition, ok := modeDefinitions[m]; ok {
+		return definition.family
+	}
+	return UnknownFamily
+}
+
+// Steps returns the mode's step pattern in semitones, or nil for an unknown mode.
+func (m MusicalMode) Steps() []int {
+	return slices.Clone(modeDefinitions[m].steps)
+}
+
+// ChromaticDegrees returns the degrees of a twelve-note chromatic scale that the mode selects, including the octave.
+func (m MusicalMode) ChromaticDegrees() []int {
+	var degrees = []int{0}
+	for _, step := range modeDefinitions[m].steps {
+		degrees = append(degrees, degrees[len(degrees)-1]+step)
 	}
+	return degrees
 }
+
+func (m MusicalMode) IsDiatonic() bool {
+	return m.Family() == DiatonicFamily
+}
+
 func (m MusicalMode) String() string {
 	return string(m)
 }
+
+// ionianRotation returns how many degrees of the major scale the mode starts above Ionian, or -1 for non-diatonic modes.
+func (m MusicalMode) ionianRotation() int {
+	return slices.Index([]MusicalMode{IonianMode, DorianMode, PhrygianMode, LydianMode, MixolydianMode, AeolianMode, LocrianMode}, m)
+}
+
+// scaleDegreesOf maps the mode's chromatic degrees onto a scale with a multiple of twelve notes per octave.
+func (m MusicalMode) scaleDegreesOf(degreesInScale int) ([]int, error) {
+	if _, ok := modeDefinitions[m]; !ok {
+		return nil, fmt.Errorf("%s is not a known mode", m)
+	}
+	if degreesInScale == 0 || degreesInScale%12 != 0 {
+		return nil, fmt.Errorf("%s mode needs a scale with a multiple of twelve notes but scale has %d notes", m, degreesInScale)
+	}
+	var degrees []int
+	for _, degree := range m.ChromaticDegrees() {
+		degrees = append(degrees, degree*degreesInScale/12)
+	}
+	return degrees, nil
+}
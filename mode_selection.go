@@ -0,0 +1,45 @@
+package music
+
+import "fmt"
+
+// Select realises a mode in a scale with a multiple of twelve notes per octave by picking the degrees of its step
+// pattern, starting from the scale's tonic.
+func (s JustScale) Select(mode MusicalMode) (JustScale, error) {
+	intervals := withoutOctave(s.Intervals())
+	degrees, err := mode.scaleDegreesOf(len(intervals))
+	if err != nil {
+		return JustScale{}, err
+	}
+	return JustScale{
+		system:      s.system,
+		description: fmt.Sprintf("%s Selected in %s mode.", s.description, mode),
+		algorithm: func() []JustInterval {
+			intervals := append(withoutOctave(s.Intervals()), Octave())
+			var selected []JustInterval
+			for _, degree := range degrees {
+				selected = append(selected, intervals[degree])
+			}
+			return selected
+		},
+	}, nil
+}
+
+func (s TemperedScale) Select(mode MusicalMode) (TemperedScale, error) {
+	intervals := withoutTemperedOctave(s.Intervals())
+	degrees, err := mode.scaleDegreesOf(len(intervals))
+	if err != nil {
+		return TemperedScale{}, err
+	}
+	return TemperedScale{
+		system:      s.system,
+		description: fmt.Sprintf("%s Selected in %s mode.", s.description, mode),
+		algorithm: func() []TemperedInterval {
+			intervals := append(withoutTemperedOctave(s.Intervals()), 2.0)
+			var selected []TemperedInterval
+			for _, degree := range degrees {
+				selected = append(selected, intervals[degree])
+			}
+			return selected
+		},
+	}, nil
+}
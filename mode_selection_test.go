@@ -0,0 +1,59 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldSelectHarmonicMinorFromJustChromaticScale(t *testing.T) {
+	// Given
+	scale := New5LimitJustIntonationChromaticScale(Asymmetric)
+
+	// When
+	harmonicMinor, err := scale.Select(HarmonicMinorMode)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "5-limit Just Intonation", harmonicMinor.System())
+	assert.Equal(t, "5-limit just intonation pure ratios derived from third- and fifth-partial ratios. Selected in Harmonic Minor mode.", harmonicMinor.Description())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {9, 8}, {6, 5}, {4, 3}, {3, 2}, {8, 5}, {15, 8}, {2, 1}}), harmonicMinor.Intervals())
+}
+
+func Test_ShouldSelectWholeToneScaleFromQuarterToneEqualTemperament(t *testing.T) {
+	// When
+	wholeTone, err := NewEqualTemperamentScale(24).Select(WholeToneMode)
+
+	// Then
+	assert.NoError(t, err)
+	intervals := wholeTone.Intervals()
+	assert.Equal(t, 7, len(intervals))
+	for i, interval := range intervals {
+		assert.InDelta(t, float64(i*200), interval.ToCents(), 0.01)
+	}
+}
+
+func Test_ShouldSelectBluesScaleFromBachTemperament(t *testing.T) {
+	// When
+	blues, err := NewBachWohltemperierteKlavierScale().Select(BluesMode)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []TemperedInterval{1.0, 1.19, 1.336, 1.415, 1.5, 1.785, 2.0}, blues.Intervals())
+}
+
+func Test_ShouldNotSelectModeFromScaleWithoutTwelveNotes(t *testing.T) {
+	// When
+	_, err := NewPythagoreanScale().Select(IonianMode)
+
+	// Then
+	assert.EqualError(t, err, "Ionian mode needs a scale with a multiple of twelve notes but scale has 13 notes")
+}
+
+func Test_ShouldNotSelectUnknownMode(t *testing.T) {
+	// When
+	_, err := NewEqualTemperamentScale(12).Select("Athenian")
+
+	// Then
+	assert.EqualError(t, err, "Athenian is not a known mode")
+}
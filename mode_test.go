@@ -1,6 +1,9 @@
 package music
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestMusicalMode_IsDiatonic(t *testing.T) {
 	tests := []struct {
@@ -48,3 +51,84 @@ func TestMusicalMode_String(t *testing.T) {
 		})
 	}
 }
+
+func TestMusicalMode_Family(t *testing.T) {
+	tests := []struct {
+		name string
+		m    MusicalMode
+		want ModeFamily
+	}{
+		{
+			name: "Dorian is in the diatonic family",
+			m:    DorianMode,
+			want: DiatonicFamily,
+		},
+		{
+			name: "Altered is a mode of melodic minor",
+			m:    AlteredMode,
+			want: MelodicMinorFamily,
+		},
+		{
+			name: "Phrygian dominant is a mode of harmonic minor",
+			m:    PhrygianDominantMode,
+			want: HarmonicMinorFamily,
+		},
+		{
+			name: "Messiaen's second mode is the octatonic scale",
+			m:    MessiaenSecondMode,
+			want: LimitedTranspositionFamily,
+		},
+		{
+			name: "Athenian is unknown",
+			m:    "Athenian",
+			want: UnknownFamily,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Family(); got != tt.want {
+				t.Errorf("Family() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMusicalMode_Steps(t *testing.T) {
+	for _, m := range Modes() {
+		t.Run(m.String(), func(t *testing.T) {
+			sum := 0
+			for _, step := range m.Steps() {
+				sum += step
+			}
+			if sum != 12 {
+				t.Errorf("Steps() of %v span %d semitones, want 12", m, sum)
+			}
+		})
+	}
+}
+
+func TestMusicalMode_ChromaticDegrees(t *testing.T) {
+	tests := []struct {
+		name string
+		m    MusicalMode
+		want []int
+	}{
+		{
+			name: "Harmonic minor selects the augmented second between sixth and seventh",
+			m:    HarmonicMinorMode,
+			want: []int{0, 2, 3, 5, 7, 8, 11, 12},
+		},
+		{
+			name: "Major pentatonic",
+			m:    MajorPentatonicMode,
+			want: []int{0, 2, 4, 7, 9, 12},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.ChromaticDegrees(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChromaticDegrees() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
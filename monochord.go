@@ -0,0 +1,146 @@
+package music
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Monochord a single string of a given length over a movable bridge, the instrument on which tunings were historically
+// set out. Lengths are in whatever unit the string length is given in.
+type Monochord struct {
+	stringLength float64
+}
+
+func NewMonochord(stringLength float64) (Monochord, error) {
+	if !(stringLength > 0) {
+		return Monochord{}, fmt.Errorf("monochord needs a positive string length but was given %g", stringLength)
+	}
+	return Monochord{stringLength: stringLength}, nil
+}
+
+func (m Monochord) StringLength() float64 {
+	return m.stringLength
+}
+
+// BridgePosition where to place the bridge to sound a degree: Length is the vibrating length, the string length times
+// the reciprocal of the interval, and Remainder the rest of the string on the other side of the bridge.
+type BridgePosition struct {
+	Degree    int
+	Interval  JustInterval
+	Length    float64
+	Remainder float64
+}
+
+func (m Monochord) Positions(scale JustScale) []BridgePosition {
+	var positions []BridgePosition
+	for degree, interval := range scale.Intervals() {
+		length := m.stringLength * interval.Reciprocal().ToFloat()
+		positions = append(positions, BridgePosition{Degree: degree, Interval: interval, Length: length, Remainder: m.stringLength - length})
+	}
+	return positions
+}
+
+// MeasuredDegree a vibrating length measured on the monochord, with the interval it sounds, the simplest nearby just
+// ratio and how many cents the measurement deviates from that ratio.
+type MeasuredDegree struct {
+	Length    float64
+	Cents     float64
+	Nearest   JustInterval
+	Deviation float64
+}
+
+// MonochordReading the degrees read from measured bridge positions.
+type MonochordReading struct {
+	stringLength float64
+	limit        uint
+	degrees      []MeasuredDegree
+}
+
+// Read turns measured vibrating lengths into degrees, matching each to the nearest ratio whose numerator and
+// denominator are at most limit and preferring the simpler ratio of two equally near. The open string is the unison
+// and every length must lie within the octave above it, between half the string and the whole.
+func (m Monochord) Read(lengths []float64, limit uint) (MonochordReading, error) {
+	if limit == 0 {
+		return MonochordReading{}, fmt.Errorf("ratio limit must be at least 1")
+	}
+	reading := MonochordReading{stringLength: m.stringLength, limit: limit}
+	for _, length := range lengths {
+		if length < m.stringLength/2 || length > m.stringLength {
+			return MonochordReading{}, fmt.Errorf("vibrating length %g must be at least half and at most the string length %g", length, m.stringLength)
+		}
+		cents := centsInOctave * math.Log2(m.stringLength/length)
+		nearest, ok := nearestRatioTo(m.stringLength/length, limit)
+		if !ok {
+			return MonochordReading{}, fmt.Errorf("no ratio with terms up to %d lies near vibrating length %g", limit, length)
+		}
+		reading.degrees = append(reading.degrees, MeasuredDegree{Length: length, Cents: cents, Nearest: nearest, Deviation: cents - nearest.ToCents()})
+	}
+	slices.SortStableFunc(reading.degrees, func(a, b MeasuredDegree) int { return cmp.Compare(b.Length, a.Length) })
+	return reading, nil
+}
+
+func (r MonochordReading) Degrees() []MeasuredDegree {
+	return r.degrees
+}
+
+// JustScale the nearest ratios to the measurements, from the unison to the octave.
+func (r MonochordReading) JustScale() JustScale {
+	return JustScale{
+		system:      "Monochord",
+		description: fmt.Sprintf("Nearest ratios with terms up to %d to bridge positions measured on a string of length %g.", r.limit, r.stringLength),
+		algorithm: func() []JustInterval {
+			intervals := []JustInterval{Unison()}
+			for _, degree := range r.degrees {
+				intervals = appendIfMissing(intervals, degree.Nearest)
+			}
+			intervals = appendIfMissing(intervals, Octave())
+			SortIntervals(intervals)
+			return intervals
+		},
+	}
+}
+
+// TemperedScale the intervals exactly as measured, from the unison to the octave.
+func (r MonochordReading) TemperedScale() TemperedScale {
+	return TemperedScale{
+		system:      "Monochord",
+		description: fmt.Sprintf("Bridge positions measured on a string of length %g.", r.stringLength),
+		algorithm: func() []TemperedInterval {
+			intervals := []TemperedInterval{1}
+			for _, degree := range r.degrees {
+				interval := TemperedInterval(r.stringLength / degree.Length)
+				if !slices.Contains(intervals, interval) {
+					intervals = append(intervals, interval)
+				}
+			}
+			if !slices.Contains(intervals, 2) {
+				intervals = append(intervals, 2)
+			}
+			slices.Sort(intervals)
+			return intervals
+		},
+	}
+}
+
+// nearestRatioTo searches every denominator up to the limit for the ratio whose terms are within the limit and which
+// lies nearest in cents to the given frequency ratio, taking the one of lower Tenney height when two are equally near.
+// It reports false when no ratio has terms within the limit.
+func nearestRatioTo(ratio float64, limit uint) (JustInterval, bool) {
+	best, bestDistance := JustInterval{}, math.Inf(1)
+	for denominator := uint(1); denominator <= limit; denominator++ {
+		numerator := uint(math.Round(ratio * float64(denominator)))
+		if numerator == 0 || numerator > limit {
+			continue
+		}
+		candidate := NewInterval(numerator, denominator)
+		distance := math.Abs(centsInOctave * math.Log2(ratio/candidate.ToFloat()))
+		nearer := distance < bestDistance-1e-9
+		asNearButSimpler := math.Abs(distance-bestDistance) <= 1e-9 && candidate.TenneyHeight() < best.TenneyHeight()
+		if nearer || asNearButSimpler {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best, !math.IsInf(bestDistance, 1)
+}
@@ -0,0 +1,111 @@
+package music
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldPlaceBridgeForEachDegreeOfIntenseDiatonicScale(t *testing.T) {
+	// Given
+	monochord, _ := NewMonochord(1200)
+
+	// When
+	positions := monochord.Positions(NewIntenseDiatonicScale(IonianMode))
+
+	// Then
+	assert.Equal(t, 8, len(positions))
+	assert.Equal(t, BridgePosition{Degree: 0, Interval: Unison(), Length: 1200, Remainder: 0}, positions[0])
+	assert.Equal(t, BridgePosition{Degree: 2, Interval: NewInterval(5, 4), Length: 960, Remainder: 240}, positions[2])
+	assert.Equal(t, BridgePosition{Degree: 4, Interval: PerfectFifth(), Length: 800, Remainder: 400}, positions[4])
+	assert.Equal(t, 600.0, positions[7].Length)
+}
+
+func Test_ShouldReadMeasuredBridgePositionsAsNearestSimpleRatios(t *testing.T) {
+	// Given
+	monochord, _ := NewMonochord(1000)
+
+	// When
+	reading, err := monochord.Read([]float64{668, 890, 801.5, 750}, 16)
+
+	// Then
+	assert.Nil(t, err)
+	degrees := reading.Degrees()
+	assert.Equal(t, 4, len(degrees))
+	assert.Equal(t, NewInterval(9, 8), degrees[0].Nearest)
+	assert.Equal(t, NewInterval(5, 4), degrees[1].Nearest)
+	assert.Equal(t, PerfectFourth(), degrees[2].Nearest)
+	assert.Equal(t, PerfectFifth(), degrees[3].Nearest)
+	assert.InDelta(t, 201.75, degrees[0].Cents, 0.01)
+	assert.InDelta(t, -2.16, degrees[0].Deviation, 0.01)
+	assert.InDelta(t, 0, degrees[2].Deviation, 1e-9)
+	assert.InDelta(t, -3.46, degrees[3].Deviation, 0.01)
+}
+
+func Test_ShouldReconstructJustAndTemperedScalesFromReading(t *testing.T) {
+	// Given
+	monochord, _ := NewMonochord(1000)
+	reading, _ := monochord.Read([]float64{668, 890, 801.5, 750}, 16)
+
+	// When
+	just := reading.JustScale()
+	tempered := reading.TemperedScale()
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {9, 8}, {5, 4}, {4, 3}, {3, 2}, {2, 1}}), just.Intervals())
+	assert.Equal(t, "Monochord", just.System())
+	assert.Equal(t, []float64{0, 201.75, 383.07, 498.04, 698.5, 1200}, tempered.Cents())
+}
+
+func Test_ShouldMatchNearestRatioWithinSmallLimit(t *testing.T) {
+	// Given
+	monochord, _ := NewMonochord(1000)
+
+	// When
+	reading, _ := monochord.Read([]float64{810}, 5)
+
+	// Then
+	assert.Equal(t, NewInterval(5, 4), reading.Degrees()[0].Nearest)
+}
+
+func Test_ShouldPreferSimplerOfTwoEquallyNearRatios(t *testing.T) {
+	// Given
+	length := 1000 / math.Sqrt(3) // midway in cents between 3/2 and 2/1
+	monochord, _ := NewMonochord(1000)
+
+	// When
+	reading, err := monochord.Read([]float64{length}, 3)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, Octave(), reading.Degrees()[0].Nearest)
+	assert.InDelta(t, -249.02, reading.Degrees()[0].Deviation, 0.01)
+}
+
+func Test_ShouldRejectMeasurementsOffTheString(t *testing.T) {
+	// Given
+	monochord, _ := NewMonochord(1000)
+
+	// When
+	_, tooLong := monochord.Read([]float64{1200}, 16)
+	_, tooShort := monochord.Read([]float64{400}, 16)
+	_, noLimit := monochord.Read([]float64{500}, 0)
+	_, noRatio := monochord.Read([]float64{600}, 1)
+
+	// Then
+	assert.EqualError(t, tooLong, "vibrating length 1200 must be at least half and at most the string length 1000")
+	assert.EqualError(t, tooShort, "vibrating length 400 must be at least half and at most the string length 1000")
+	assert.EqualError(t, noLimit, "ratio limit must be at least 1")
+	assert.EqualError(t, noRatio, "no ratio with terms up to 1 lies near vibrating length 600")
+}
+
+func Test_ShouldRejectMonochordWithoutPositiveStringLength(t *testing.T) {
+	// When
+	_, zero := NewMonochord(0)
+	_, negative := NewMonochord(-1000)
+
+	// Then
+	assert.EqualError(t, zero, "monochord needs a positive string length but was given 0")
+	assert.EqualError(t, negative, "monochord needs a positive string length but was given -1000")
+}
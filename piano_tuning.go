@@ -0,0 +1,162 @@
+package music
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// OctaveType which partials of two notes an octave apart are tuned to coincide: for 4:2 the second partial of the
+// upper note matches the fourth partial of the lower. Wider types stretch the octaves further.
+type OctaveType string
+
+const (
+	Octave2to1 OctaveType = "2:1"
+	Octave4to2 OctaveType = "4:2"
+	Octave6to3 OctaveType = "6:3"
+)
+
+func (o OctaveType) String() string {
+	return string(o)
+}
+
+// partials returns the partial of the lower note and the partial of the upper note that are made to coincide, and
+// false for an unknown octave type.
+func (o OctaveType) partials() (lower, upper float64, ok bool) {
+	switch o {
+	case Octave2to1:
+		return 2, 1, true
+	case Octave4to2:
+		return 4, 2, true
+	case Octave6to3:
+		return 6, 3, true
+	}
+	return 0, 0, false
+}
+
+const (
+	pianoKeys         = 88
+	concertPitchKey   = 49
+	concertPitch      = 440.0
+	middleOctaveStart = 40
+)
+
+// InharmonicityCurve gives the inharmonicity coefficient B of the string of each key, numbered 1 (A0) to 88 (C8).
+type InharmonicityCurve func(key int) float64
+
+// DefaultInharmonicity a smooth curve for a typical grand piano, lowest around the break between wound and plain
+// strings and rising steeply into the treble, from about 2.5e-4 at A0 and 1.9e-4 at A4 to 6e-3 at C8. It is a fit of
+// the general shape reported in the literature; measure real instruments where you can.
+// Reference: Robert W. Young, "Inharmonicity of Plain Wire Piano Strings", JASA 24 (1952)
+func DefaultInharmonicity(key int) float64 {
+	return math.Exp(0.09*float64(key)-13) + math.Exp(-0.1*float64(key)-8.2)
+}
+
+// PianoKey the tuning of one key: the frequency of its first partial and how many cents it is stretched from the base scale and from
+// twelve-tone equal temperament, the latter being what a Railsback curve plots.
+type PianoKey struct {
+	Number                     int
+	Inharmonicity              float64
+	Frequency                  float64
+	OffsetFromBase             float64
+	OffsetFromEqualTemperament float64
+}
+
+// PianoTuning stretches a twelve-note base scale across the 88 keys of a piano. The octave from middle C is set from
+// the base scale with A4 at 440Hz, and each octave above and below is tuned from the one before so that the partials
+// of the octave type coincide, given each string's inharmonicity: partial n of a string sounds at n·f·√(1+B·n²),
+// where f is the frequency of the ideal flexible string.
+type PianoTuning struct {
+	base          []float64
+	octave        OctaveType
+	inharmonicity InharmonicityCurve
+}
+
+// NewPianoTuning uses DefaultInharmonicity when inharmonicity is nil. The base scale's first degree is taken as C.
+func NewPianoTuning(base TemperedScale, octave OctaveType, inharmonicity InharmonicityCurve) (PianoTuning, error) {
+	cents := centsWithoutOctave(base.Cents())
+	if len(cents) != 12 {
+		return PianoTuning{}, fmt.Errorf("piano tuning needs a twelve-note base scale but %s has %d notes", base.System(), len(cents))
+	}
+	if _, _, ok := octave.partials(); !ok {
+		return PianoTuning{}, fmt.Errorf("piano tuning needs a 2:1, 4:2 or 6:3 octave but was given %s", octave)
+	}
+	if inharmonicity == nil {
+		inharmonicity = DefaultInharmonicity
+	}
+	return PianoTuning{base: cents, octave: octave, inharmonicity: inharmonicity}, nil
+}
+
+func (p PianoTuning) Keys() []PianoKey {
+	frequencies := make([]float64, pianoKeys+1)
+	for key := middleOctaveStart; key < middleOctaveStart+12; key++ {
+		frequencies[key] = p.baseFrequency(key)
+	}
+	lowerPartial, upperPartial, _ := p.octave.partials()
+	for key := middleOctaveStart + 12; key <= pianoKeys; key++ {
+		frequencies[key] = frequencies[key-12] * p.partialRatio(key-12, lowerPartial) / p.partialRatio(key, upperPartial)
+	}
+	for key := middleOctaveStart - 1; key >= 1; key-- {
+		frequencies[key] = frequencies[key+12] * p.partialRatio(key+12, upperPartial) / p.partialRatio(key, lowerPartial)
+	}
+
+	var keys []PianoKey
+	for key := 1; key <= pianoKeys; key++ {
+		keys = append(keys, PianoKey{
+			Number:                     key,
+			Inharmonicity:              p.inharmonicity(key),
+			Frequency:                  frequencies[key],
+			OffsetFromBase:             centsInOctave * math.Log2(frequencies[key]/p.baseFrequency(key)),
+			OffsetFromEqualTemperament: centsInOctave * math.Log2(frequencies[key]/equalTemperedFrequency(key)),
+		})
+	}
+	return keys
+}
+
+// RailsbackCurve each key's offset in cents from twelve-tone equal temperament, from A0 to C8.
+func (p PianoTuning) RailsbackCurve() []float64 {
+	var curve []float64
+	for _, key := range p.Keys() {
+		curve = append(curve, key.OffsetFromEqualTemperament)
+	}
+	return curve
+}
+
+func (p PianoTuning) CSV() (string, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	records := [][]string{{"key", "inharmonicity", "frequency", "offset from base", "offset from equal temperament"}}
+	for _, key := range p.Keys() {
+		records = append(records, []string{
+			strconv.Itoa(key.Number),
+			strconv.FormatFloat(key.Inharmonicity, 'e', 3, 64),
+			strconv.FormatFloat(key.Frequency, 'f', 3, 64),
+			formatCents(key.OffsetFromBase),
+			formatCents(key.OffsetFromEqualTemperament),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return "", fmt.Errorf("cannot write piano tuning as CSV: %w", err)
+	}
+	return buffer.String(), nil
+}
+
+// partialRatio how far above the sounding first partial the nth partial of the key's string sounds.
+func (p PianoTuning) partialRatio(key int, n float64) float64 {
+	b := p.inharmonicity(key)
+	return n * math.Sqrt(1+b*n*n) / math.Sqrt(1+b)
+}
+
+// baseFrequency the key's frequency in the base scale without stretching, with A4 at concert pitch.
+func (p PianoTuning) baseFrequency(key int) float64 {
+	middleC := concertPitch / math.Exp2(p.base[concertPitchKey-middleOctaveStart]/centsInOctave)
+	steps := key - middleOctaveStart
+	octaves := math.Floor(float64(steps) / 12)
+	return middleC * math.Exp2(octaves+p.base[wrapDegree(steps, 12)]/centsInOctave)
+}
+
+func equalTemperedFrequency(key int) float64 {
+	return concertPitch * math.Exp2(float64(key-concertPitchKey)/12)
+}
@@ -0,0 +1,107 @@
+package music
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldNotStretchStringsWithoutInharmonicity(t *testing.T) {
+	// Given
+	tuning, err := NewPianoTuning(NewEqualTemperamentScale(12), Octave4to2, func(int) float64 { return 0 })
+	assert.Nil(t, err)
+
+	// When
+	keys := tuning.Keys()
+
+	// Then
+	assert.Equal(t, 88, len(keys))
+	assert.InDelta(t, 27.5, keys[0].Frequency, 1e-9)
+	assert.InDelta(t, 4186.01, keys[87].Frequency, 0.01)
+	for _, key := range keys {
+		assert.InDelta(t, 0, key.OffsetFromEqualTemperament, 1e-9)
+	}
+}
+
+func Test_ShouldStretchBassFlatAndTrebleSharpWithDefaultInharmonicity(t *testing.T) {
+	// Given
+	tuning, _ := NewPianoTuning(NewEqualTemperamentScale(12), Octave2to1, nil)
+
+	// When
+	curve := tuning.RailsbackCurve()
+
+	// Then
+	assert.Equal(t, 88, len(curve))
+	assert.InDelta(t, 0, curve[48], 1e-9)
+	assert.InDelta(t, -1.16, curve[0], 0.01)
+	assert.InDelta(t, 8.18, curve[87], 0.01)
+	for key := 51; key < 88; key += 12 {
+		assert.Greater(t, curve[key], curve[key-12], "key %d", key+1)
+	}
+	for key := 27; key >= 0; key -= 12 {
+		assert.Less(t, curve[key], curve[key+12], "key %d", key+1)
+	}
+}
+
+func Test_ShouldStretchWiderOctaveTypesFurther(t *testing.T) {
+	// Given
+	twoToOne, _ := NewPianoTuning(NewEqualTemperamentScale(12), Octave2to1, nil)
+	fourToTwo, _ := NewPianoTuning(NewEqualTemperamentScale(12), Octave4to2, nil)
+	sixToThree, _ := NewPianoTuning(NewEqualTemperamentScale(12), Octave6to3, nil)
+
+	// When
+	top := []float64{twoToOne.RailsbackCurve()[87], fourToTwo.RailsbackCurve()[87], sixToThree.RailsbackCurve()[87]}
+	bottom := []float64{twoToOne.RailsbackCurve()[0], fourToTwo.RailsbackCurve()[0], sixToThree.RailsbackCurve()[0]}
+
+	// Then
+	assert.Less(t, top[0], top[1])
+	assert.Less(t, top[1], top[2])
+	assert.Greater(t, bottom[0], bottom[1])
+	assert.Greater(t, bottom[1], bottom[2])
+}
+
+func Test_ShouldKeepBaseTemperamentInMiddleOctave(t *testing.T) {
+	// Given
+	tuning, _ := NewPianoTuning(NewBachWohltemperierteKlavierScale(), Octave2to1, nil)
+
+	// When
+	keys := tuning.Keys()
+
+	// Then
+	assert.InDelta(t, 440.0, keys[48].Frequency, 1e-9)
+	assert.InDelta(t, 0, keys[39].OffsetFromBase, 1e-9)
+	assert.InDelta(t, -0.21, keys[39].OffsetFromEqualTemperament, 0.01)
+	assert.Greater(t, keys[87].OffsetFromBase, 5.0)
+}
+
+func Test_ShouldWritePianoTuningAsCSV(t *testing.T) {
+	// Given
+	tuning, _ := NewPianoTuning(NewEqualTemperamentScale(12), Octave2to1, nil)
+
+	// When
+	csv, err := tuning.CSV()
+
+	// Then
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(csv), "\n")
+	assert.Equal(t, 89, len(lines))
+	assert.Equal(t, "key,inharmonicity,frequency,offset from base,offset from equal temperament", lines[0])
+	assert.True(t, strings.HasPrefix(lines[49], "49,1.880e-04,440.000,0.00,0.00"), lines[49])
+}
+
+func Test_ShouldRejectBaseScaleWithoutTwelveNotes(t *testing.T) {
+	// When
+	_, err := NewPianoTuning(NewEqualTemperamentScale(19), Octave2to1, nil)
+
+	// Then
+	assert.EqualError(t, err, "piano tuning needs a twelve-note base scale but Equal Temperament has 19 notes")
+}
+
+func Test_ShouldRejectUnknownOctaveType(t *testing.T) {
+	// When
+	_, err := NewPianoTuning(NewEqualTemperamentScale(12), OctaveType("3:1"), nil)
+
+	// Then
+	assert.EqualError(t, err, "piano tuning needs a 2:1, 4:2 or 6:3 octave but was given 3:1")
+}
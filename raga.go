@@ -0,0 +1,141 @@
+package music
+
+import (
+	"fmt"
+	"math"
+)
+
+// Raga a melodic framework whose ascent (arohana) and descent (avarohana) may use different swaras, with its most
+// (vadi) and second most (samvadi) important swaras and its characteristic phrase (pakad).
+type Raga struct {
+	name      string
+	thaat     Thaat
+	arohana   []Swara
+	avarohana []Swara
+	vadi      Swara
+	samvadi   Swara
+	pakad     []Swara
+}
+
+func NewRaga(name string, thaat Thaat, arohana, avarohana []Swara, vadi, samvadi Swara, pakad []Swara) (Raga, error) {
+	for _, phrase := range [][]Swara{arohana, avarohana, pakad, {vadi, samvadi}} {
+		for _, swara := range phrase {
+			if _, err := swara.Shruti(); err != nil {
+				return Raga{}, fmt.Errorf("raga %s: %w", name, err)
+			}
+		}
+	}
+	return Raga{name: name, thaat: thaat, arohana: arohana, avarohana: avarohana, vadi: vadi, samvadi: samvadi, pakad: pakad}, nil
+}
+
+func RagaYaman() Raga {
+	return Raga{
+		name:      "Yaman",
+		thaat:     KalyanThaat(),
+		arohana:   []Swara{Ni.Lower(), Re, Ga, TivraMa, Dha, Ni, Sa.Upper()},
+		avarohana: []Swara{Sa.Upper(), Ni, Dha, Pa, TivraMa, Ga, Re, Sa},
+		vadi:      Ga,
+		samvadi:   Ni,
+		pakad:     []Swara{Ni.Lower(), Re, Ga, Re, Sa, Pa, Re, Sa},
+	}
+}
+
+func RagaBhupali() Raga {
+	return Raga{
+		name:      "Bhupali",
+		thaat:     KalyanThaat(),
+		arohana:   []Swara{Sa, Re, Ga, Pa, Dha, Sa.Upper()},
+		avarohana: []Swara{Sa.Upper(), Dha, Pa, Ga, Re, Sa},
+		vadi:      Ga,
+		samvadi:   Dha,
+		pakad:     []Swara{Ga, Re, Sa, Dha.Lower(), Sa, Re, Ga, Pa, Ga, Dha, Pa, Ga, Re, Sa},
+	}
+}
+
+func RagaBhairav() Raga {
+	return Raga{
+		name:      "Bhairav",
+		thaat:     BhairavThaat(),
+		arohana:   []Swara{Sa, KomalRe, Ga, Ma, Pa, KomalDha, Ni, Sa.Upper()},
+		avarohana: []Swara{Sa.Upper(), Ni, KomalDha, Pa, Ma, Ga, KomalRe, Sa},
+		vadi:      KomalDha,
+		samvadi:   KomalRe,
+		pakad:     []Swara{Sa, Ga, Ma, KomalDha, KomalDha, Pa, Ga, Ma, KomalRe, KomalRe, Sa},
+	}
+}
+
+func RagaBhimpalasi() Raga {
+	return Raga{
+		name:      "Bhimpalasi",
+		thaat:     KafiThaat(),
+		arohana:   []Swara{KomalNi.Lower(), Sa, KomalGa, Ma, Pa, KomalNi, Sa.Upper()},
+		avarohana: []Swara{Sa.Upper(), KomalNi, Dha, Pa, Ma, KomalGa, Re, Sa},
+		vadi:      Ma,
+		samvadi:   Sa,
+		pakad:     []Swara{KomalNi.Lower(), Sa, Ma, Ma, KomalGa, Pa, Ma, KomalGa, Re, Sa},
+	}
+}
+
+func (r Raga) Name() string {
+	return r.name
+}
+
+func (r Raga) Thaat() Thaat {
+	return r.thaat
+}
+
+func (r Raga) Arohana() []Swara {
+	return r.arohana
+}
+
+func (r Raga) Avarohana() []Swara {
+	return r.avarohana
+}
+
+func (r Raga) Vadi() Swara {
+	return r.vadi
+}
+
+func (r Raga) Samvadi() Swara {
+	return r.samvadi
+}
+
+func (r Raga) Pakad() []Swara {
+	return r.pakad
+}
+
+// InShrutis realises the arohana and avarohana on the 22-shruti scale.
+func (r Raga) InShrutis() (arohana, avarohana []JustInterval) {
+	return swarasToIntervals(r.arohana), swarasToIntervals(r.avarohana)
+}
+
+// InTemperedScale realises the arohana and avarohana on the degree of the scale nearest to each swara's shruti.
+func (r Raga) InTemperedScale(scale TemperedScale) (arohana, avarohana []TemperedInterval) {
+	return swarasInTemperedScale(r.arohana, scale), swarasInTemperedScale(r.avarohana, scale)
+}
+
+func swarasToIntervals(swaras []Swara) []JustInterval {
+	var intervals []JustInterval
+	for _, swara := range swaras {
+		interval, _ := swara.Interval()
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+func swarasInTemperedScale(swaras []Swara, scale TemperedScale) []TemperedInterval {
+	degrees := append(withoutTemperedOctave(scale.Intervals()), 2.0)
+	var intervals []TemperedInterval
+	for _, swara := range swaras {
+		shruti, _ := swara.Shruti()
+		_, octave := swara.octave()
+		nearest := degrees[0]
+		for _, degree := range degrees {
+			if math.Abs(degree.ToCents()-shruti.interval.ToCents()) < math.Abs(nearest.ToCents()-shruti.interval.ToCents()) {
+				nearest = degree
+			}
+		}
+		intervals = append(intervals, nearest*TemperedInterval(math.Exp2(float64(octave))))
+	}
+	return intervals
+}
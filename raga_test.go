@@ -0,0 +1,68 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldRealiseDirectionDependentRagaInShrutis(t *testing.T) {
+	// Given
+	raga := RagaBhimpalasi()
+
+	// When
+	arohana, avarohana := raga.InShrutis()
+
+	// Then
+	assert.Equal(t, "Kafi", raga.Thaat().Name())
+	assert.Equal(t, Ma, raga.Vadi())
+	assert.Equal(t, Sa, raga.Samvadi())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{9, 10}, {1, 1}, {6, 5}, {4, 3}, {3, 2}, {9, 5}, {2, 1}}), arohana)
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{2, 1}, {9, 5}, {5, 3}, {3, 2}, {4, 3}, {6, 5}, {9, 8}, {1, 1}}), avarohana)
+}
+
+func Test_ShouldRealiseRagaInTemperedScale(t *testing.T) {
+	// Given
+	raga := RagaYaman()
+
+	// When
+	arohana, avarohana := raga.InTemperedScale(NewEqualTemperamentScale(12))
+
+	// Then
+	assert.Equal(t, 7, len(arohana))
+	assert.Equal(t, 8, len(avarohana))
+	assert.InDelta(t, -100.0, arohana[0].ToCents(), 0.01)
+	assert.InDelta(t, 600.0, arohana[3].ToCents(), 0.01)
+	assert.InDelta(t, 1200.0, arohana[6].ToCents(), 0.01)
+	assert.InDelta(t, 0.0, avarohana[7].ToCents(), 0.01)
+}
+
+func Test_ShouldBuildBespokeRaga(t *testing.T) {
+	// When
+	raga, err := NewRaga("Durga", BilawalThaat(), []Swara{Sa, Re, Ma, Pa, Dha, Sa.Upper()}, []Swara{Sa.Upper(), Dha, Pa, Ma, Re, Sa}, Ma, Sa, []Swara{Ma, Re, Pa, Dha, Sa.Upper()})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "Durga", raga.Name())
+	assert.Equal(t, 6, len(raga.Arohana()))
+	assert.Equal(t, 5, len(raga.Pakad()))
+}
+
+func Test_ShouldRejectRagaWithUnknownSwara(t *testing.T) {
+	// When
+	_, err := NewRaga("Broken", BilawalThaat(), []Swara{Sa, "Xa"}, []Swara{Sa}, Sa, Pa, nil)
+
+	// Then
+	assert.EqualError(t, err, "raga Broken: Xa is not a swara")
+}
+
+func Test_ShouldOnlyUseSwarasOfItsThaatInCatalogueRagas(t *testing.T) {
+	for _, raga := range []Raga{RagaYaman(), RagaBhupali(), RagaBhairav(), RagaBhimpalasi()} {
+		t.Run(raga.Name(), func(t *testing.T) {
+			for _, swara := range append(append(raga.Arohana(), raga.Avarohana()...), raga.Pakad()...) {
+				base, _ := swara.octave()
+				assert.Contains(t, raga.Thaat().Swaras(), base)
+			}
+		})
+	}
+}
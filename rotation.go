@@ -0,0 +1,120 @@
+package music
+
+import "fmt"
+
+// Rotate returns the mode of the scale starting on the given degree, re-normalised so that degree becomes 1/1.
+// Degrees wrap around the octave, so -1 is the degree below the octave.
+func (s JustScale) Rotate(degree int) JustScale {
+	return JustScale{
+		system:      s.system,
+		description: fmt.Sprintf("%s Rotated to start on degree %d.", s.description, degree),
+		algorithm: func() []JustInterval {
+			return rotateJustIntervals(s.Intervals(), degree)
+		},
+	}
+}
+
+// InMode takes a seven-note scale to be in Ionian mode and returns it rotated to the given diatonic mode.
+func (s JustScale) InMode(mode MusicalMode) (JustScale, error) {
+	degree, err := diatonicDegreeOf(mode, len(withoutOctave(s.Intervals())))
+	if err != nil {
+		return JustScale{}, err
+	}
+	return JustScale{
+		system:      s.system,
+		description: fmt.Sprintf("%s In %s mode.", s.description, mode),
+		algorithm: func() []JustInterval {
+			return rotateJustIntervals(s.Intervals(), degree)
+		},
+	}, nil
+}
+
+func (s TemperedScale) Rotate(degree int) TemperedScale {
+	return TemperedScale{
+		system:      s.system,
+		description: fmt.Sprintf("%s Rotated to start on degree %d.", s.description, degree),
+		algorithm: func() []TemperedInterval {
+			return rotateTemperedIntervals(s.Intervals(), degree)
+		},
+	}
+}
+
+func (s TemperedScale) InMode(mode MusicalMode) (TemperedScale, error) {
+	degree, err := diatonicDegreeOf(mode, len(withoutTemperedOctave(s.Intervals())))
+	if err != nil {
+		return TemperedScale{}, err
+	}
+	return TemperedScale{
+		system:      s.system,
+		description: fmt.Sprintf("%s In %s mode.", s.description, mode),
+		algorithm: func() []TemperedInterval {
+			return rotateTemperedIntervals(s.Intervals(), degree)
+		},
+	}, nil
+}
+
+func diatonicDegreeOf(mode MusicalMode, degreesInScale int) (int, error) {
+	if !mode.IsDiatonic() {
+		return 0, fmt.Errorf("%s is not a diatonic mode", mode)
+	}
+	if degreesInScale != 7 {
+		return 0, fmt.Errorf("%s mode needs a seven-note scale but scale has %d notes", mode, degreesInScale)
+	}
+	return mode.ionianRotation(), nil
+}
+
+func rotateJustIntervals(intervals []JustInterval, degree int) []JustInterval {
+	degrees := withoutOctave(intervals)
+	if len(degrees) == 0 {
+		return intervals
+	}
+	degree = wrapDegree(degree, len(degrees))
+	tonic := degrees[degree].Reciprocal()
+
+	var rotated []JustInterval
+	for k := range degrees {
+		interval := degrees[(degree+k)%len(degrees)].Add(tonic)
+		if degree+k >= len(degrees) {
+			interval = interval.Add(Octave())
+		}
+		rotated = append(rotated, interval)
+	}
+	return append(rotated, Octave())
+}
+
+func rotateTemperedIntervals(intervals []TemperedInterval, degree int) []TemperedInterval {
+	degrees := withoutTemperedOctave(intervals)
+	if len(degrees) == 0 {
+		return intervals
+	}
+	degree = wrapDegree(degree, len(degrees))
+	tonic := degrees[degree]
+
+	var rotated []TemperedInterval
+	for k := range degrees {
+		interval := degrees[(degree+k)%len(degrees)] / tonic
+		if degree+k >= len(degrees) {
+			interval *= 2
+		}
+		rotated = append(rotated, interval)
+	}
+	return append(rotated, 2.0)
+}
+
+func wrapDegree(degree, degreesInScale int) int {
+	return ((degree % degreesInScale) + degreesInScale) % degreesInScale
+}
+
+func withoutOctave(intervals []JustInterval) []JustInterval {
+	if len(intervals) > 0 && intervals[len(intervals)-1].IsOctave() {
+		return intervals[:len(intervals)-1]
+	}
+	return intervals
+}
+
+func withoutTemperedOctave(intervals []TemperedInterval) []TemperedInterval {
+	if len(intervals) > 0 && intervals[len(intervals)-1] == 2.0 {
+		return intervals[:len(intervals)-1]
+	}
+	return intervals
+}
@@ -0,0 +1,93 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldRotateJustScaleToStartOnSecondDegree(t *testing.T) {
+	// Given
+	scale := NewIntenseDiatonicScale(IonianMode)
+
+	// When
+	rotated := scale.Rotate(1)
+
+	// Then
+	assert.Equal(t, "Ptolemy Intense Diatonic", rotated.System())
+	assert.Equal(t, "Ptolemy's 5-limit intense diatonic scale in Ionian mode. Rotated to start on degree 1.", rotated.Description())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {10, 9}, {32, 27}, {4, 3}, {40, 27}, {5, 3}, {16, 9}, {2, 1}}), rotated.Intervals())
+}
+
+func Test_ShouldRotateJustScaleToStartOnDegreeBelowOctave(t *testing.T) {
+	// When
+	intervals := NewIntenseDiatonicScale(IonianMode).Rotate(-1).Intervals()
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {16, 15}, {6, 5}, {4, 3}, {64, 45}, {8, 5}, {16, 9}, {2, 1}}), intervals)
+}
+
+func Test_ShouldReturnSameScaleWhenRotatedByWholeOctave(t *testing.T) {
+	// Given
+	scale := NewSazScale()
+
+	// Then
+	assert.Equal(t, scale.Intervals(), scale.Rotate(17).Intervals())
+}
+
+func Test_ShouldApplyDiatonicModeToSevenNoteJustScale(t *testing.T) {
+	// Given
+	pythagoreanMajor := NewJustIntonationChromaticScaleWith("Pythagorean major.", [][]uint{{1, 1}, {9, 8}, {81, 64}, {4, 3}, {3, 2}, {27, 16}, {243, 128}, {2, 1}})
+
+	// When
+	scale, err := pythagoreanMajor.InMode(DorianMode)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "Pythagorean major. In Dorian mode.", scale.Description())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {9, 8}, {32, 27}, {4, 3}, {3, 2}, {27, 16}, {16, 9}, {2, 1}}), scale.Intervals())
+}
+
+func Test_ShouldNotApplyDiatonicModeToChromaticScale(t *testing.T) {
+	// When
+	_, err := NewBachWohltemperierteKlavierScale().InMode(PhrygianMode)
+
+	// Then
+	assert.EqualError(t, err, "Phrygian mode needs a seven-note scale but scale has 12 notes")
+}
+
+func Test_ShouldNotApplyNonDiatonicMode(t *testing.T) {
+	// When
+	_, err := NewIntenseDiatonicScale(IonianMode).InMode("Athenian")
+
+	// Then
+	assert.EqualError(t, err, "Athenian is not a diatonic mode")
+}
+
+func Test_ShouldRotateTemperedScale(t *testing.T) {
+	// Given
+	scale := NewEqualTemperamentScale(12)
+
+	// When
+	intervals := scale.Rotate(3).Intervals()
+
+	// Then
+	assert.Equal(t, 13, len(intervals))
+	for i, interval := range scale.Intervals() {
+		assert.InDelta(t, interval.ToFloat(), intervals[i].ToFloat(), 1e-9)
+	}
+}
+
+func Test_ShouldApplyDiatonicModeToSevenNoteTemperedScale(t *testing.T) {
+	// Given
+	scale := NewEqualTemperamentScale(7)
+
+	// When
+	phrygian, err := scale.InMode(PhrygianMode)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(phrygian.Intervals()))
+	assert.Equal(t, "7-tone equal temperament. In Phrygian mode.", phrygian.Description())
+	assert.InDelta(t, scale.Intervals()[1].ToFloat(), phrygian.Intervals()[1].ToFloat(), 1e-9)
+}
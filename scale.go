@@ -10,3 +10,10 @@ type Scale interface {
 	Description() string
 	Intervals() []any
 }
+
+// CentsScale any scale, just or tempered, described by the cents of its degrees above the tonic, octave included.
+type CentsScale interface {
+	System() string
+	Description() string
+	Cents() []float64
+}
@@ -0,0 +1,280 @@
+package music
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+type Alignment string
+
+const (
+	AlignByIndex        Alignment = "index"
+	AlignByNearestCents Alignment = "nearest cents"
+)
+
+func (a Alignment) String() string {
+	return string(a)
+}
+
+// AlignedDegree the degree of a compared scale aligned with one degree of the reference scale. Present is false when
+// the scale has no degree at that index.
+type AlignedDegree struct {
+	Degree    int
+	Cents     float64
+	Deviation float64
+	Present   bool
+}
+
+type DegreeComparison struct {
+	Degree         int
+	ReferenceCents float64
+	Aligned        []AlignedDegree
+}
+
+// DeviationStatistics summarise how far a scale deviates from the reference, in absolute cents.
+type DeviationStatistics struct {
+	Max  float64
+	Mean float64
+	RMS  float64
+}
+
+// ScaleComparison aligns each degree of a reference scale (excluding its octave) with a degree of every compared scale.
+type ScaleComparison struct {
+	alignment  Alignment
+	reference  CentsScale
+	scales     []CentsScale
+	degrees    []DegreeComparison
+	statistics []DeviationStatistics
+}
+
+// CompareScales fails for an alignment other than AlignByIndex or AlignByNearestCents.
+func CompareScales(alignment Alignment, reference CentsScale, scales ...CentsScale) (ScaleComparison, error) {
+	if alignment != AlignByIndex && alignment != AlignByNearestCents {
+		return ScaleComparison{}, fmt.Errorf("cannot compare scales aligned by unknown alignment %q", alignment)
+	}
+	referenceCents := centsWithoutOctave(reference.Cents())
+	var scalesCents [][]float64
+	for _, scale := range scales {
+		scalesCents = append(scalesCents, centsWithoutOctave(scale.Cents()))
+	}
+
+	var degrees []DegreeComparison
+	for degree, referenceCent := range referenceCents {
+		comparison := DegreeComparison{Degree: degree, ReferenceCents: referenceCent}
+		for _, cents := range scalesCents {
+			comparison.Aligned = append(comparison.Aligned, alignDegree(alignment, degree, referenceCent, cents))
+		}
+		degrees = append(degrees, comparison)
+	}
+
+	var statistics []DeviationStatistics
+	for i := range scales {
+		var deviations []float64
+		for _, comparison := range degrees {
+			if aligned := comparison.Aligned[i]; aligned.Present {
+				deviations = append(deviations, aligned.Deviation)
+			}
+		}
+		statistics = append(statistics, deviationStatisticsOf(deviations))
+	}
+
+	return ScaleComparison{alignment: alignment, reference: reference, scales: scales, degrees: degrees, statistics: statistics}, nil
+}
+
+func alignDegree(alignment Alignment, degree int, referenceCents float64, cents []float64) AlignedDegree {
+	if alignment == AlignByNearestCents {
+		nearest := -1
+		for i, c := range cents {
+			if nearest < 0 || math.Abs(c-referenceCents) < math.Abs(cents[nearest]-referenceCents) {
+				nearest = i
+			}
+		}
+		degree = nearest
+	}
+	if degree < 0 || degree >= len(cents) {
+		return AlignedDegree{Degree: degree}
+	}
+	return AlignedDegree{Degree: degree, Cents: cents[degree], Deviation: cents[degree] - referenceCents, Present: true}
+}
+
+func deviationStatisticsOf(deviations []float64) DeviationStatistics {
+	if len(deviations) == 0 {
+		return DeviationStatistics{}
+	}
+	var statistics DeviationStatistics
+	var sum, sumOfSquares float64
+	for _, deviation := range deviations {
+		statistics.Max = math.Max(statistics.Max, math.Abs(deviation))
+		sum += math.Abs(deviation)
+		sumOfSquares += deviation * deviation
+	}
+	statistics.Mean = sum / float64(len(deviations))
+	statistics.RMS = math.Sqrt(sumOfSquares / float64(len(deviations)))
+	return statistics
+}
+
+func (c ScaleComparison) Alignment() Alignment {
+	return c.alignment
+}
+
+func (c ScaleComparison) Reference() CentsScale {
+	return c.reference
+}
+
+func (c ScaleComparison) Scales() []CentsScale {
+	return c.scales
+}
+
+func (c ScaleComparison) Degrees() []DegreeComparison {
+	return c.degrees
+}
+
+// Statistics returns the deviation statistics of each compared scale, in the order they were given.
+func (c ScaleComparison) Statistics() []DeviationStatistics {
+	return c.statistics
+}
+
+// Table renders the comparison as a plain-text table with each scale's cents and deviation per degree, followed by
+// the maximum, mean and RMS deviations.
+func (c ScaleComparison) Table() string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	labels := c.labels()
+
+	header := []string{"Degree", labels[0]}
+	for _, label := range labels[1:] {
+		header = append(header, label, "Deviation")
+	}
+	fmt.Fprintln(writer, strings.Join(header, "\t")+"\t")
+
+	for _, row := range c.rows() {
+		fmt.Fprintln(writer, strings.Join(row, "\t")+"\t")
+	}
+	for _, row := range c.statisticsRows() {
+		fmt.Fprintln(writer, strings.Join(row, "\t")+"\t")
+	}
+	writer.Flush()
+	return buffer.String()
+}
+
+func (c ScaleComparison) CSV() (string, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	labels := c.labels()
+
+	header := []string{"degree", labels[0]}
+	for _, label := range labels[1:] {
+		header = append(header, label, label+" deviation")
+	}
+	records := append([][]string{header}, c.rows()...)
+	records = append(records, c.statisticsRows()...)
+	if err := writer.WriteAll(records); err != nil {
+		return "", fmt.Errorf("cannot write scale comparison as CSV: %w", err)
+	}
+	return buffer.String(), nil
+}
+
+type scaleComparisonJSON struct {
+	Alignment string                 `json:"alignment"`
+	Reference string                 `json:"reference"`
+	Scales    []scaleDeviationJSON   `json:"scales"`
+	Degrees   []degreeComparisonJSON `json:"degrees"`
+}
+
+type scaleDeviationJSON struct {
+	Name string  `json:"name"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+	RMS  float64 `json:"rms"`
+}
+
+type degreeComparisonJSON struct {
+	Degree         int                 `json:"degree"`
+	ReferenceCents float64             `json:"referenceCents"`
+	Aligned        []alignedDegreeJSON `json:"aligned"`
+}
+
+type alignedDegreeJSON struct {
+	Degree    *int     `json:"degree"`
+	Cents     *float64 `json:"cents"`
+	Deviation *float64 `json:"deviation"`
+}
+
+func (c ScaleComparison) JSON() ([]byte, error) {
+	labels := c.labels()
+	report := scaleComparisonJSON{Alignment: c.alignment.String(), Reference: labels[0]}
+	for i, statistics := range c.statistics {
+		report.Scales = append(report.Scales, scaleDeviationJSON{Name: labels[i+1], Max: statistics.Max, Mean: statistics.Mean, RMS: statistics.RMS})
+	}
+	for _, comparison := range c.degrees {
+		degree := degreeComparisonJSON{Degree: comparison.Degree, ReferenceCents: comparison.ReferenceCents}
+		for _, aligned := range comparison.Aligned {
+			var alignedJSON alignedDegreeJSON
+			if aligned.Present {
+				alignedJSON = alignedDegreeJSON{Degree: &aligned.Degree, Cents: &aligned.Cents, Deviation: &aligned.Deviation}
+			}
+			degree.Aligned = append(degree.Aligned, alignedJSON)
+		}
+		report.Degrees = append(report.Degrees, degree)
+	}
+
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("cannot write scale comparison as JSON: %w", err)
+	}
+	return encoded, nil
+}
+
+func (c ScaleComparison) rows() [][]string {
+	var rows [][]string
+	for _, comparison := range c.degrees {
+		row := []string{strconv.Itoa(comparison.Degree), formatCents(comparison.ReferenceCents)}
+		for _, aligned := range comparison.Aligned {
+			if !aligned.Present {
+				row = append(row, "", "")
+				continue
+			}
+			row = append(row, formatCents(aligned.Cents), formatCents(aligned.Deviation))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (c ScaleComparison) statisticsRows() [][]string {
+	rows := [][]string{{"max", ""}, {"mean", ""}, {"rms", ""}}
+	for _, statistics := range c.statistics {
+		rows[0] = append(rows[0], "", formatCents(statistics.Max))
+		rows[1] = append(rows[1], "", formatCents(statistics.Mean))
+		rows[2] = append(rows[2], "", formatCents(statistics.RMS))
+	}
+	return rows
+}
+
+// labels names the reference and then each compared scale by system, adding the description where systems repeat.
+func (c ScaleComparison) labels() []string {
+	all := append([]CentsScale{c.reference}, c.scales...)
+	systems := map[string]int{}
+	for _, scale := range all {
+		systems[scale.System()]++
+	}
+	var labels []string
+	for _, scale := range all {
+		if systems[scale.System()] > 1 {
+			labels = append(labels, fmt.Sprintf("%s: %s", scale.System(), scale.Description()))
+			continue
+		}
+		labels = append(labels, scale.System())
+	}
+	return labels
+}
+
+func formatCents(cents float64) string {
+	return strconv.FormatFloat(cents, 'f', 2, 64)
+}
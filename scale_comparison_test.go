@@ -0,0 +1,108 @@
+package music
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldCompareBachAndEqualTemperamentWithEqualTemperamentByIndex(t *testing.T) {
+	// Given
+	reference := NewEqualTemperamentScale(12)
+
+	// When
+	comparison, _ := CompareScales(AlignByIndex, reference, NewBachWohltemperierteKlavierScale(), NewEqualTemperamentScale(12))
+
+	// Then
+	degrees := comparison.Degrees()
+	assert.Equal(t, 12, len(degrees))
+	assert.Equal(t, 700.0, degrees[7].ReferenceCents)
+	assert.Equal(t, AlignedDegree{Degree: 7, Cents: 701.96, Deviation: 1.96, Present: true}, roundAligned(degrees[7].Aligned[0]))
+	assert.Equal(t, AlignedDegree{Degree: 7, Cents: 700, Deviation: 0, Present: true}, degrees[7].Aligned[1])
+
+	statistics := comparison.Statistics()
+	assert.InDelta(t, 3.11, statistics[0].Max, 0.01)
+	assert.InDelta(t, 1.63, statistics[0].Mean, 0.01)
+	assert.InDelta(t, 1.88, statistics[0].RMS, 0.01)
+	assert.Equal(t, DeviationStatistics{}, statistics[1])
+}
+
+func Test_ShouldAlignScalesOfDifferentSizesByNearestCents(t *testing.T) {
+	// When
+	comparison, _ := CompareScales(AlignByNearestCents, NewEqualTemperamentScale(12), NewExtendedQuarterCommaMeantoneScale())
+
+	// Then
+	degrees := comparison.Degrees()
+	assert.Equal(t, 12, len(degrees))
+	assert.Equal(t, 2, degrees[1].Aligned[0].Degree)
+	assert.InDelta(t, 17.13, degrees[1].Aligned[0].Deviation, 0.01)
+	assert.Equal(t, AlignByNearestCents, comparison.Alignment())
+}
+
+func Test_ShouldLeaveMissingDegreesEmptyWhenAlignedByIndex(t *testing.T) {
+	// When
+	comparison, _ := CompareScales(AlignByIndex, NewEqualTemperamentScale(12), NewSlendroScale())
+
+	// Then
+	assert.True(t, comparison.Degrees()[4].Aligned[0].Present)
+	assert.False(t, comparison.Degrees()[5].Aligned[0].Present)
+}
+
+func Test_ShouldRenderComparisonAsTable(t *testing.T) {
+	// When
+	comparison, _ := CompareScales(AlignByIndex, NewSlendroScale(), NewEqualTemperamentScale(5))
+	table := comparison.Table()
+
+	// Then
+	lines := strings.Split(strings.TrimRight(table, "\n"), "\n")
+	assert.Equal(t, 9, len(lines))
+	assert.Equal(t, []string{"Degree", "Gamelan", "Slendro", "Equal", "Temperament", "Deviation"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"1", "240.00", "240.00", "0.00"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"rms", "0.00"}, strings.Fields(lines[8]))
+}
+
+func Test_ShouldRenderComparisonAsCSV(t *testing.T) {
+	// When
+	comparison, _ := CompareScales(AlignByIndex, NewEqualTemperamentScale(12), NewEqualTemperamentScale(24))
+	csv, err := comparison.CSV()
+
+	// Then
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimRight(csv, "\n"), "\n")
+	assert.Equal(t, "degree,Equal Temperament: 12-tone equal temperament.,Equal Temperament: 24-tone equal temperament.,Equal Temperament: 24-tone equal temperament. deviation", lines[0])
+	assert.Equal(t, "1,100.00,50.00,-50.00", lines[2])
+	assert.Equal(t, "max,,,550.00", lines[13])
+}
+
+func Test_ShouldRenderComparisonAsJSON(t *testing.T) {
+	// When
+	comparison, _ := CompareScales(AlignByIndex, NewEqualTemperamentScale(12), NewSlendroScale())
+	encoded, err := comparison.JSON()
+
+	// Then
+	assert.NoError(t, err)
+	var report map[string]any
+	assert.NoError(t, json.Unmarshal(encoded, &report))
+	assert.Equal(t, "index", report["alignment"])
+	assert.Equal(t, "Equal Temperament", report["reference"])
+	assert.Equal(t, "Gamelan Slendro", report["scales"].([]any)[0].(map[string]any)["name"])
+	degrees := report["degrees"].([]any)
+	assert.Equal(t, 240.0, degrees[1].(map[string]any)["aligned"].([]any)[0].(map[string]any)["cents"])
+	assert.Nil(t, degrees[11].(map[string]any)["aligned"].([]any)[0].(map[string]any)["cents"])
+}
+
+func Test_ShouldRejectUnknownAlignment(t *testing.T) {
+	// When
+	_, err := CompareScales(Alignment("by eye"), NewEqualTemperamentScale(12), NewSlendroScale())
+
+	// Then
+	assert.EqualError(t, err, `cannot compare scales aligned by unknown alignment "by eye"`)
+}
+
+func roundAligned(aligned AlignedDegree) AlignedDegree {
+	aligned.Cents = toDecimalPlaces(aligned.Cents, 2)
+	aligned.Deviation = toDecimalPlaces(aligned.Deviation, 2)
+	return aligned
+}
@@ -0,0 +1,200 @@
+package music
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+const centsInOctave = 1200.0
+
+// ScaleMatch a candidate scale, optionally in one of the MusicalMode step patterns, that fits a set of pitches.
+// Tonic is the pitch of the input, in cents, that the scale's 1/1 falls on. Score is the harmonic mean of the
+// proportion of pitches the scale explains and the proportion of the scale's degrees heard.
+type ScaleMatch struct {
+	Scale         CentsScale
+	Mode          MusicalMode
+	Tonic         float64
+	Score         float64
+	MeanDeviation float64
+}
+
+type ScaleRecogniser struct {
+	toleranceInCents float64
+	candidates       []recognitionCandidate
+}
+
+type recognitionCandidate struct {
+	scale CentsScale
+	mode  MusicalMode
+	cents []float64
+}
+
+// NewScaleRecogniser builds a recogniser over the given scales, or over LibraryScales when none are given. Scales
+// with at least twelve notes per octave are also tried in every mode of the MusicalMode catalogue, taking the degree
+// nearest in cents to each note of the mode, so that gamuts with enharmonic pairs such as the meantones have modes too.
+func NewScaleRecogniser(toleranceInCents float64, scales ...CentsScale) ScaleRecogniser {
+	if len(scales) == 0 {
+		scales = LibraryScales()
+	}
+	var candidates []recognitionCandidate
+	for _, scale := range scales {
+		cents := centsWithoutOctave(scale.Cents())
+		candidates = append(candidates, recognitionCandidate{scale: scale, cents: cents})
+		if len(cents) < 12 {
+			continue
+		}
+		for _, mode := range Modes() {
+			if modeCents, ok := modeCentsIn(mode, cents); ok {
+				candidates = append(candidates, recognitionCandidate{scale: scale, mode: mode, cents: modeCents})
+			}
+		}
+	}
+	return ScaleRecogniser{toleranceInCents: toleranceInCents, candidates: candidates}
+}
+
+// modeCentsIn selects the degree of the scale nearest to each note of the mode, reporting false if two notes would
+// share a degree or a note other than the octave would fall on the octave.
+func modeCentsIn(mode MusicalMode, cents []float64) ([]float64, bool) {
+	var targets []float64
+	for _, degree := range mode.ChromaticDegrees() {
+		targets = append(targets, float64(degree)*100)
+	}
+	degrees, err := nearestDegreesOf(targets, cents)
+	if err != nil {
+		return nil, false
+	}
+	var modeCents []float64
+	for _, degree := range degrees[:len(degrees)-1] {
+		if degree == len(cents) {
+			return nil, false
+		}
+		modeCents = append(modeCents, cents[degree])
+	}
+	return modeCents, true
+}
+
+// LibraryScales the scales the library ships that a recogniser compares pitches against by default.
+func LibraryScales() []CentsScale {
+	scales := []CentsScale{
+		NewEqualTemperamentScale(12),
+		NewBachWohltemperierteKlavierScale(),
+		NewQuarterCommaMeantoneScale(),
+		NewExtendedQuarterCommaMeantoneScale(),
+		NewPythagoreanScale(),
+		New5LimitPythagoreanScale(),
+		New5LimitJustIntonationChromaticScale(Asymmetric),
+		New5LimitJustIntonationChromaticScale(Symmetric1),
+		New5LimitJustIntonationChromaticScale(Symmetric2),
+		New7LimitJustIntonationChromaticScale(),
+		New13LimitJustIntonationChromaticScale(),
+		NewSazScale(),
+	}
+	for _, mode := range []MusicalMode{IonianMode, DorianMode, PhrygianMode, LydianMode, MixolydianMode, AeolianMode, LocrianMode} {
+		scales = append(scales, NewIntenseDiatonicScale(mode))
+	}
+	return scales
+}
+
+// Identify ranks every candidate scale, mode and tonic against the pitches, best match first.
+func (r ScaleRecogniser) Identify(cents []float64) []ScaleMatch {
+	pitches := pitchClassesOf(cents)
+	if len(pitches) == 0 {
+		return nil
+	}
+
+	var matches []ScaleMatch
+	for _, candidate := range r.candidates {
+		var best ScaleMatch
+		for i, tonic := range pitches {
+			match := r.match(candidate, pitches, tonic)
+			if i == 0 || match.Score > best.Score || (match.Score == best.Score && match.MeanDeviation < best.MeanDeviation) {
+				best = match
+			}
+		}
+		matches = append(matches, best)
+	}
+
+	slices.SortStableFunc(matches, func(a, b ScaleMatch) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+		return cmp.Compare(a.MeanDeviation, b.MeanDeviation)
+	})
+	return matches
+}
+
+func (r ScaleRecogniser) IdentifyIntervals(intervals []JustInterval) []ScaleMatch {
+	var cents []float64
+	for _, interval := range intervals {
+		cents = append(cents, interval.ToCents())
+	}
+	return r.Identify(cents)
+}
+
+// IdentifyPitchClasses takes MIDI-style note numbers or pitch classes, where 0 is the first degree of 12-EDO.
+func (r ScaleRecogniser) IdentifyPitchClasses(pitchClasses []int) []ScaleMatch {
+	var cents []float64
+	for _, pitchClass := range pitchClasses {
+		cents = append(cents, float64(pitchClass)*100)
+	}
+	return r.Identify(cents)
+}
+
+func (r ScaleRecogniser) match(candidate recognitionCandidate, pitches []float64, tonic float64) ScaleMatch {
+	explained, totalDeviation := 0, 0.0
+	for _, pitch := range pitches {
+		if deviation := nearestDistance(reduceCents(pitch-tonic), candidate.cents); deviation <= r.toleranceInCents {
+			explained++
+			totalDeviation += deviation
+		}
+	}
+
+	heard := 0
+	for _, degree := range candidate.cents {
+		if nearestDistance(reduceCents(degree+tonic), pitches) <= r.toleranceInCents {
+			heard++
+		}
+	}
+
+	match := ScaleMatch{Scale: candidate.scale, Mode: candidate.mode, Tonic: tonic, MeanDeviation: math.Inf(1)}
+	if explained == 0 || heard == 0 {
+		return match
+	}
+	precision := float64(explained) / float64(len(pitches))
+	recall := float64(heard) / float64(len(candidate.cents))
+	match.Score = 2 * precision * recall / (precision + recall)
+	match.MeanDeviation = totalDeviation / float64(explained)
+	return match
+}
+
+func pitchClassesOf(cents []float64) []float64 {
+	var pitchClasses []float64
+	for _, c := range cents {
+		if pc := reduceCents(c); !slices.Contains(pitchClasses, pc) {
+			pitchClasses = append(pitchClasses, pc)
+		}
+	}
+	return pitchClasses
+}
+
+func reduceCents(cents float64) float64 {
+	return math.Mod(math.Mod(cents, centsInOctave)+centsInOctave, centsInOctave)
+}
+
+// nearestDistance is the smallest distance round the octave from cents to any of the others.
+func nearestDistance(cents float64, others []float64) float64 {
+	nearest := math.Inf(1)
+	for _, other := range others {
+		distance := math.Abs(reduceCents(cents) - reduceCents(other))
+		nearest = math.Min(nearest, math.Min(distance, centsInOctave-distance))
+	}
+	return nearest
+}
+
+func centsWithoutOctave(cents []float64) []float64 {
+	if len(cents) > 0 && math.Abs(cents[len(cents)-1]-centsInOctave) < 1e-6 {
+		return cents[:len(cents)-1]
+	}
+	return cents
+}
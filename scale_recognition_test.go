@@ -0,0 +1,97 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldIdentifySazScaleFromItsIntervals(t *testing.T) {
+	// Given
+	recogniser := NewScaleRecogniser(5)
+
+	// When
+	matches := recogniser.IdentifyIntervals(NewSazScale().Intervals())
+
+	// Then
+	assert.Equal(t, "Saz", matches[0].Scale.System())
+	assert.Equal(t, MusicalMode(""), matches[0].Mode)
+	assert.Equal(t, 0.0, matches[0].Tonic)
+	assert.Equal(t, 1.0, matches[0].Score)
+	assert.InDelta(t, 0.0, matches[0].MeanDeviation, 1e-9)
+}
+
+func Test_ShouldIdentifyHarmonicMinorAndItsTonicFromPitchClasses(t *testing.T) {
+	// Given
+	recogniser := NewScaleRecogniser(1, NewEqualTemperamentScale(12))
+
+	// When
+	matches := recogniser.IdentifyPitchClasses([]int{62, 64, 65, 67, 69, 70, 73})
+
+	// Then
+	assert.Equal(t, 1.0, matches[0].Score)
+	harmonicMinor := matchInMode(matches, HarmonicMinorMode)
+	assert.Equal(t, 1.0, harmonicMinor.Score)
+	assert.Equal(t, 200.0, harmonicMinor.Tonic)
+	for _, match := range matches {
+		if match.Mode.Family() == DiatonicFamily {
+			assert.Less(t, match.Score, 1.0)
+		}
+	}
+}
+
+func Test_ShouldPreferJustScaleWhenPitchesAreJust(t *testing.T) {
+	// Given
+	recogniser := NewScaleRecogniser(3, NewEqualTemperamentScale(12), NewIntenseDiatonicScale(IonianMode))
+
+	// When
+	matches := recogniser.IdentifyIntervals(NewIntenseDiatonicScale(IonianMode).Intervals())
+
+	// Then
+	assert.Equal(t, "Ptolemy Intense Diatonic", matches[0].Scale.System())
+	assert.Equal(t, 1.0, matches[0].Score)
+}
+
+func Test_ShouldTolerateConfiguredCentsDeviation(t *testing.T) {
+	// Given
+	pitches := []float64{0, 410, 700}
+	scale := NewEqualTemperamentScale(12)
+
+	// When
+	tolerant := NewScaleRecogniser(15, scale).Identify(pitches)
+	strict := NewScaleRecogniser(5, scale).Identify(pitches)
+
+	// Then
+	tolerantIonian := matchInMode(tolerant, IonianMode)
+	assert.InDelta(t, 0.6, tolerantIonian.Score, 1e-9)
+	assert.InDelta(t, 10.0/3, tolerantIonian.MeanDeviation, 1e-9)
+	assert.InDelta(t, 0.4, matchInMode(strict, IonianMode).Score, 1e-9)
+}
+
+func matchInMode(matches []ScaleMatch, mode MusicalMode) ScaleMatch {
+	for _, match := range matches {
+		if match.Mode == mode {
+			return match
+		}
+	}
+	return ScaleMatch{}
+}
+
+func Test_ShouldReturnNoMatchesForNoPitches(t *testing.T) {
+	assert.Nil(t, NewScaleRecogniser(5).Identify(nil))
+}
+
+func Test_ShouldIdentifyDorianModeOfQuarterCommaMeantone(t *testing.T) {
+	// Given
+	recogniser := NewScaleRecogniser(1, NewQuarterCommaMeantoneScale())
+
+	// When
+	matches := recogniser.Identify([]float64{0, 193.16, 310.26, 503.42, 696.58, 889.74, 1006.84})
+
+	// Then
+	dorian := matchInMode(matches, DorianMode)
+	assert.Equal(t, "Quarter-Comma Meantone", dorian.Scale.System())
+	assert.Equal(t, 1.0, dorian.Score)
+	assert.Equal(t, 0.0, dorian.Tonic)
+	assert.Less(t, dorian.MeanDeviation, 0.5)
+}
@@ -0,0 +1,240 @@
+package music
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// ScaleProperty the outcome of testing a scale for one structural property, with a sentence saying why it does or
+// does not hold.
+type ScaleProperty struct {
+	Name        string
+	Holds       bool
+	Explanation string
+}
+
+func (p ScaleProperty) String() string {
+	return fmt.Sprintf("%s: %t (%s)", p.Name, p.Holds, p.Explanation)
+}
+
+// Structure tests every structural property, using universe as the number of equal steps to the octave against which
+// maximal evenness is judged.
+func (c IntervalContent) Structure(universe int) []ScaleProperty {
+	return []ScaleProperty{
+		c.StrictlyProper(),
+		c.Proper(),
+		c.MyhillsProperty(),
+		c.DistributionallyEven(),
+		c.MaximallyEven(universe),
+		c.ConstantStructure(),
+		c.WellFormed(),
+		c.Epimorphic(),
+	}
+}
+
+// Proper Rothenberg propriety: no generic interval is ever larger than any interval of more steps.
+// Reference: David Rothenberg, "A model for pattern perception with musical applications", Mathematical Systems Theory 11 (1978)
+func (c IntervalContent) Proper() ScaleProperty {
+	return c.propriety("Proper", "larger than", func(larger, smaller float64) bool { return larger > smaller })
+}
+
+// StrictlyProper Rothenberg strict propriety: every generic interval is smaller than any interval of more steps.
+func (c IntervalContent) StrictlyProper() ScaleProperty {
+	return c.propriety("Strictly proper", "at least", func(larger, smaller float64) bool { return larger >= smaller })
+}
+
+func (c IntervalContent) propriety(name, violation string, violates func(larger, smaller float64) bool) ScaleProperty {
+	sizes := c.GenericIntervalSizes()
+	for steps := 1; steps < len(sizes); steps++ {
+		largest, smallest := slices.Max(sizes[steps-1]), slices.Min(sizes[steps])
+		if violates(largest, smallest) {
+			return ScaleProperty{Name: name, Explanation: fmt.Sprintf("the largest %d-step interval (%s cents) is %s the smallest %d-step interval (%s cents)",
+				steps, formatCents(largest), violation, steps+1, formatCents(smallest))}
+		}
+	}
+	return ScaleProperty{Name: name, Holds: true, Explanation: "the ranges of interval sizes for each number of steps do not overlap"}
+}
+
+// Stability Rothenberg's stability: the fraction of intervals between degrees whose size does not fall within the range
+// of sizes of any other number of steps, 1 for a strictly proper scale.
+func (c IntervalContent) Stability() float64 {
+	sizes := c.GenericIntervalSizes()
+	total, ambiguous := 0, 0
+	for i := range c.cents {
+		for steps := 1; steps < len(c.cents); steps++ {
+			size := toDecimalPlaces(c.cents[i][(i+steps)%len(c.cents)], 2)
+			total++
+			for other := range sizes {
+				if other != steps-1 && size >= slices.Min(sizes[other]) && size <= slices.Max(sizes[other]) {
+					ambiguous++
+					break
+				}
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 1 - float64(ambiguous)/float64(total)
+}
+
+// MyhillsProperty every generic interval comes in exactly two specific sizes.
+func (c IntervalContent) MyhillsProperty() ScaleProperty {
+	const name = "Myhill's property"
+	if c.degrees() < 2 {
+		return ScaleProperty{Name: name, Explanation: "a scale needs at least two degrees"}
+	}
+	for steps, sizes := range c.GenericIntervalSizes() {
+		if len(sizes) != 2 {
+			return ScaleProperty{Name: name, Explanation: fmt.Sprintf("the %d-step interval comes in %d sizes", steps+1, len(sizes))}
+		}
+	}
+	return ScaleProperty{Name: name, Holds: true, Explanation: "every generic interval comes in exactly two sizes"}
+}
+
+// DistributionallyEven every generic interval comes in at most two specific sizes.
+func (c IntervalContent) DistributionallyEven() ScaleProperty {
+	const name = "Distributionally even"
+	for steps, sizes := range c.GenericIntervalSizes() {
+		if len(sizes) > 2 {
+			return ScaleProperty{Name: name, Explanation: fmt.Sprintf("the %d-step interval comes in %d sizes", steps+1, len(sizes))}
+		}
+	}
+	return ScaleProperty{Name: name, Holds: true, Explanation: "every generic interval comes in at most two sizes"}
+}
+
+// MaximallyEven with each degree taken to the nearest step of an equal division of the octave into universe steps,
+// every generic interval spans either one number of steps or two consecutive numbers of steps.
+// Reference: John Clough and Jack Douthett, "Maximally even sets", Journal of Music Theory 35 (1991)
+func (c IntervalContent) MaximallyEven(universe int) ScaleProperty {
+	name := fmt.Sprintf("Maximally even in %d-EDO", universe)
+	if universe < c.degrees() {
+		return ScaleProperty{Name: name, Explanation: fmt.Sprintf("%d degrees do not fit in a universe of %d steps", c.degrees(), universe)}
+	}
+
+	var pitchClasses []int
+	for _, cents := range c.firstRow() {
+		pitchClass := int(math.Round(cents*float64(universe)/centsInOctave)) % universe
+		if slices.Contains(pitchClasses, pitchClass) {
+			return ScaleProperty{Name: name, Explanation: fmt.Sprintf("two degrees fall on step %d", pitchClass)}
+		}
+		pitchClasses = append(pitchClasses, pitchClass)
+	}
+
+	for steps := 1; steps < len(pitchClasses); steps++ {
+		var spans []int
+		for i := range pitchClasses {
+			spans = append(spans, (pitchClasses[(i+steps)%len(pitchClasses)]-pitchClasses[i]+universe)%universe)
+		}
+		if slices.Max(spans)-slices.Min(spans) > 1 {
+			return ScaleProperty{Name: name, Explanation: fmt.Sprintf("the %d-step interval spans from %d to %d steps of %d-EDO", steps, slices.Min(spans), slices.Max(spans), universe)}
+		}
+	}
+	return ScaleProperty{Name: name, Holds: true, Explanation: fmt.Sprintf("every generic interval spans one or two consecutive numbers of steps of %d-EDO", universe)}
+}
+
+// ConstantStructure each interval size between degrees always spans the same number of steps.
+func (c IntervalContent) ConstantStructure() ScaleProperty {
+	const name = "Constant structure"
+	generic := c.GenericIntervalSizes()
+	for first, sizes := range generic {
+		for second, others := range generic[first+1:] {
+			for _, size := range sizes {
+				if slices.Contains(others, size) {
+					return ScaleProperty{Name: name, Explanation: fmt.Sprintf("%s cents spans both %d and %d steps", formatCents(size), first+1, first+second+2)}
+				}
+			}
+		}
+	}
+	return ScaleProperty{Name: name, Holds: true, Explanation: "no interval size spans more than one number of steps"}
+}
+
+// WellFormed the scale is a chain of a single generator reduced into the octave with the generator always spanning the
+// same number of steps, which for all but equal divisions is equivalent to Myhill's property.
+// Reference: Norman Carey and David Clampitt, "Aspects of well-formed scales", Music Theory Spectrum 11 (1989)
+func (c IntervalContent) WellFormed() ScaleProperty {
+	const name = "Well-formed"
+	sizes := c.GenericIntervalSizes()
+	if len(sizes) == 0 {
+		return ScaleProperty{Name: name, Explanation: "a scale needs at least two degrees"}
+	}
+	if !slices.ContainsFunc(sizes, func(s []float64) bool { return len(s) != 1 }) {
+		return ScaleProperty{Name: name, Holds: true, Explanation: "an equal division of the octave is degenerately well-formed"}
+	}
+	if !c.MyhillsProperty().Holds {
+		return ScaleProperty{Name: name, Explanation: "not every generic interval comes in exactly two sizes"}
+	}
+
+	for steps := 1; steps < c.degrees(); steps++ {
+		counts := map[float64]int{}
+		for i := range c.cents {
+			counts[toDecimalPlaces(c.cents[i][(i+steps)%c.degrees()], 2)]++
+		}
+		for size, count := range counts {
+			if count == c.degrees()-1 {
+				return ScaleProperty{Name: name, Holds: true, Explanation: fmt.Sprintf("generated by %s cents spanning %d steps", formatCents(size), steps)}
+			}
+		}
+	}
+	return ScaleProperty{Name: name, Explanation: "no interval generates the scale"}
+}
+
+// Epimorphic some val maps every degree of a just scale onto its own index, so that the scale is a faithful image of an
+// equal division with as many steps as it has degrees. The patent val of that division and its neighbours, which
+// differ by a step on any prime, are tried.
+func (c IntervalContent) Epimorphic() ScaleProperty {
+	const name = "Epimorphic"
+	if c.just == nil {
+		return ScaleProperty{Name: name, Explanation: "only a just scale can be epimorphic"}
+	}
+
+	degrees := c.just[0]
+	var primes []uint
+	for _, degree := range degrees {
+		for prime := range degree.primeExponents() {
+			if prime != 2 && !slices.Contains(primes, prime) {
+				primes = append(primes, prime)
+			}
+		}
+	}
+	slices.Sort(primes)
+
+	edo := float64(c.degrees())
+	for variant := range int(math.Pow(3, float64(len(primes)))) {
+		val := map[uint]int{2: c.degrees()}
+		for n, prime := range primes {
+			val[prime] = int(math.Round(edo*math.Log2(float64(prime)))) + []int{0, 1, -1}[variant/int(math.Pow(3, float64(n)))%3]
+		}
+		if valMapsDegreesToIndices(val, degrees) {
+			return ScaleProperty{Name: name, Holds: true, Explanation: fmt.Sprintf("the val %s maps each degree onto its index", formatVal(val, primes))}
+		}
+	}
+	return ScaleProperty{Name: name, Explanation: fmt.Sprintf("no val near the patent val of %d-EDO maps each degree onto its index", c.degrees())}
+}
+
+func valMapsDegreesToIndices(val map[uint]int, degrees []JustInterval) bool {
+	for index, degree := range degrees {
+		steps := 0
+		for prime, exponent := range degree.primeExponents() {
+			steps += val[prime] * exponent
+		}
+		if steps != index {
+			return false
+		}
+	}
+	return true
+}
+
+func formatVal(val map[uint]int, primes []uint) string {
+	mapping := []string{fmt.Sprint(val[2])}
+	for _, prime := range primes {
+		mapping = append(mapping, fmt.Sprint(val[prime]))
+	}
+	return "<" + strings.Join(mapping, " ") + "]"
+}
+
+func (c IntervalContent) degrees() int {
+	return len(c.cents)
+}
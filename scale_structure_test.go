@@ -0,0 +1,108 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldFindPtolemysIntenseDiatonicStrictlyProperButNotWellFormed(t *testing.T) {
+	// Given
+	content := NewIntenseDiatonicScale(IonianMode).IntervalContent()
+
+	// When
+	properties := content.Structure(12)
+
+	// Then
+	assert.Equal(t, []bool{true, true, false, false, true, true, false, true}, holdsOf(properties))
+	assert.Equal(t, 1.0, content.Stability())
+	assert.Equal(t, "the val <7 11 16] maps each degree onto its index", properties[7].Explanation)
+}
+
+func Test_ShouldFindPythagoreanDiatonicImproperButWellFormed(t *testing.T) {
+	// Given
+	scale, _ := NewTetrachordScale(PtolemyDitonicDiatonic(), PtolemyDitonicDiatonic(), Disjunct, IonianMode)
+	content := scale.IntervalContent()
+
+	// When
+	properties := content.Structure(12)
+
+	// Then
+	assert.Equal(t, []bool{false, false, true, true, true, true, true, true}, holdsOf(properties))
+	assert.Equal(t, "the largest 3-step interval (611.73 cents) is larger than the smallest 4-step interval (588.27 cents)", properties[1].Explanation)
+	assert.Equal(t, "generated by 498.04 cents spanning 3 steps", properties[6].Explanation)
+	assert.InDelta(t, 40.0/42.0, content.Stability(), 0.0001)
+}
+
+func Test_ShouldFindEqualTemperedMajorScaleProperButNotConstantStructure(t *testing.T) {
+	// Given
+	major, _ := NewEqualTemperamentScale(12).Select(IonianMode)
+	content := major.IntervalContent()
+
+	// When
+	properties := content.Structure(12)
+
+	// Then
+	assert.Equal(t, []bool{false, true, true, true, true, false, true, false}, holdsOf(properties))
+	assert.Equal(t, "600.00 cents spans both 3 and 4 steps", properties[5].Explanation)
+	assert.Equal(t, "only a just scale can be epimorphic", properties[7].Explanation)
+}
+
+func Test_ShouldFindEqualTemperamentDegeneratelyWellFormed(t *testing.T) {
+	// Given
+	content := NewEqualTemperamentScale(12).IntervalContent()
+
+	// When
+	wellFormed := content.WellFormed()
+
+	// Then
+	assert.True(t, wellFormed.Holds)
+	assert.False(t, content.MyhillsProperty().Holds)
+	assert.True(t, content.MaximallyEven(12).Holds)
+	assert.Equal(t, 1.0, content.Stability())
+}
+
+func Test_ShouldFindPentatonicNotMaximallyEvenInTooSmallUniverse(t *testing.T) {
+	// Given
+	content := NewEqualTemperamentScale(12).IntervalContent()
+
+	// When
+	maximallyEven := content.MaximallyEven(7)
+
+	// Then
+	assert.False(t, maximallyEven.Holds)
+	assert.Equal(t, "12 degrees do not fit in a universe of 7 steps", maximallyEven.Explanation)
+}
+
+func Test_ShouldFindSazScaleStrictlyProperConstantStructureAndEpimorphic(t *testing.T) {
+	// Given
+	content := NewSazScale().IntervalContent()
+
+	// When
+	properties := content.Structure(17)
+
+	// Then
+	assert.Equal(t, []bool{true, true, false, false, false, true, false, true}, holdsOf(properties))
+	assert.Equal(t, "the val <17 27 59 70] maps each degree onto its index", properties[7].Explanation)
+}
+
+func Test_ShouldFindPythagoreanChromaticNeitherProperNorConstantStructure(t *testing.T) {
+	// Given
+	content := NewPythagoreanScale().IntervalContent()
+
+	// When
+	properties := content.Structure(12)
+
+	// Then
+	assert.Equal(t, []bool{false, false, false, false, false, false, false, false}, holdsOf(properties))
+	assert.Equal(t, "113.69 cents spans both 1 and 2 steps", properties[5].Explanation)
+	assert.InDelta(t, 0.18, content.Stability(), 0.01)
+}
+
+func holdsOf(properties []ScaleProperty) []bool {
+	var holds []bool
+	for _, property := range properties {
+		holds = append(holds, property.Holds)
+	}
+	return holds
+}
@@ -0,0 +1,221 @@
+package music
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Shruti one of the twenty-two microtonal divisions of the octave in Indian classical music.
+type Shruti struct {
+	name     string
+	interval JustInterval
+}
+
+func (s Shruti) Name() string {
+	return s.name
+}
+
+func (s Shruti) Interval() JustInterval {
+	return s.interval
+}
+
+func (s Shruti) String() string {
+	return fmt.Sprintf("%s (%s)", s.name, s.interval)
+}
+
+// Shrutis the twenty-two shrutis with their traditional names, as commonly mapped onto 5-limit and Pythagorean ratios.
+// Reference: https://en.wikipedia.org/wiki/Shruti_(music)
+func Shrutis() []Shruti {
+	return []Shruti{
+		{"Chandovati", JustInterval{numerator: 1, denominator: 1}},
+		{"Dayavati", JustInterval{numerator: 256, denominator: 243}},
+		{"Ranjani", JustInterval{numerator: 16, denominator: 15}},
+		{"Ratika", JustInterval{numerator: 10, denominator: 9}},
+		{"Raudri", JustInterval{numerator: 9, denominator: 8}},
+		{"Krodha", JustInterval{numerator: 32, denominator: 27}},
+		{"Vajrika", JustInterval{numerator: 6, denominator: 5}},
+		{"Prasarini", JustInterval{numerator: 5, denominator: 4}},
+		{"Priti", JustInterval{numerator: 81, denominator: 64}},
+		{"Marjani", JustInterval{numerator: 4, denominator: 3}},
+		{"Kshiti", JustInterval{numerator: 27, denominator: 20}},
+		{"Rakta", JustInterval{numerator: 45, denominator: 32}},
+		{"Sandipani", JustInterval{numerator: 729, denominator: 512}},
+		{"Alapini", JustInterval{numerator: 3, denominator: 2}},
+		{"Madanti", JustInterval{numerator: 128, denominator: 81}},
+		{"Rohini", JustInterval{numerator: 8, denominator: 5}},
+		{"Ramya", JustInterval{numerator: 5, denominator: 3}},
+		{"Ugra", JustInterval{numerator: 27, denominator: 16}},
+		{"Kshobhini", JustInterval{numerator: 16, denominator: 9}},
+		{"Tivra", JustInterval{numerator: 9, denominator: 5}},
+		{"Kumudvati", JustInterval{numerator: 15, denominator: 8}},
+		{"Manda", JustInterval{numerator: 243, denominator: 128}},
+	}
+}
+
+func NewShrutiScale() JustScale {
+	return JustScale{
+		system:      "Shruti",
+		description: "Indian 22-shruti just intonation scale.",
+		algorithm:   computeShrutiScale,
+	}
+}
+
+func computeShrutiScale() []JustInterval {
+	var intervals []JustInterval
+	for _, shruti := range Shrutis() {
+		intervals = append(intervals, shruti.interval)
+	}
+	return append(intervals, Octave())
+}
+
+// ShrutiName returns the name of the shruti at the interval, octave reduced, or "" if it is not a shruti.
+func ShrutiName(i JustInterval) string {
+	reduced := i.OctaveReduce().Simplify()
+	for _, shruti := range Shrutis() {
+		if shruti.interval.IsEqualTo(reduced) {
+			return shruti.name
+		}
+	}
+	return ""
+}
+
+// Swara a note of the sargam. A trailing ' raises it to the upper (tar) octave and a trailing , lowers it to the
+// lower (mandra) octave, so "Ni," is the Ni below Sa.
+type Swara string
+
+const (
+	Sa       Swara = "Sa"
+	KomalRe  Swara = "komal Re"
+	Re       Swara = "Re"
+	KomalGa  Swara = "komal Ga"
+	Ga       Swara = "Ga"
+	Ma       Swara = "Ma"
+	TivraMa  Swara = "tivra Ma"
+	Pa       Swara = "Pa"
+	KomalDha Swara = "komal Dha"
+	Dha      Swara = "Dha"
+	KomalNi  Swara = "komal Ni"
+	Ni       Swara = "Ni"
+)
+
+var swaraShrutis = map[Swara]int{
+	Sa: 0, KomalRe: 2, Re: 4, KomalGa: 6, Ga: 7, Ma: 9, TivraMa: 11, Pa: 13, KomalDha: 15, Dha: 16, KomalNi: 19, Ni: 20,
+}
+
+func (s Swara) Upper() Swara {
+	return s + "'"
+}
+
+func (s Swara) Lower() Swara {
+	return s + ","
+}
+
+func (s Swara) String() string {
+	return string(s)
+}
+
+// Shruti returns the shruti the swara sits on, ignoring its octave.
+func (s Swara) Shruti() (Shruti, error) {
+	base, _ := s.octave()
+	index, ok := swaraShrutis[base]
+	if !ok {
+		return Shruti{}, fmt.Errorf("%s is not a swara", s)
+	}
+	return Shrutis()[index], nil
+}
+
+// Interval returns the swara's interval above middle Sa, taking its octave into account.
+func (s Swara) Interval() (JustInterval, error) {
+	shruti, err := s.Shruti()
+	if err != nil {
+		return JustInterval{}, err
+	}
+	interval := shruti.interval
+	_, octave := s.octave()
+	for ; octave > 0; octave-- {
+		interval = interval.Add(Octave())
+	}
+	for ; octave < 0; octave++ {
+		interval = interval.Add(Octave().Reciprocal())
+	}
+	return interval, nil
+}
+
+func (s Swara) octave() (Swara, int) {
+	name := string(s)
+	octave := strings.Count(name, "'") - strings.Count(name, ",")
+	return Swara(strings.TrimRight(name, "',")), octave
+}
+
+// Thaat one of Bhatkhande's ten parent scales of Hindustani music.
+type Thaat struct {
+	name   string
+	swaras []Swara
+}
+
+func (t Thaat) Name() string {
+	return t.name
+}
+
+func (t Thaat) Swaras() []Swara {
+	return t.swaras
+}
+
+func (t Thaat) Scale() JustScale {
+	return JustScale{
+		system:      "Shruti",
+		description: fmt.Sprintf("%s thaat on the 22 shrutis.", t.name),
+		algorithm: func() []JustInterval {
+			var intervals []JustInterval
+			for _, swara := range t.swaras {
+				interval, _ := swara.Interval()
+				intervals = append(intervals, interval)
+			}
+			return append(intervals, Octave())
+		},
+	}
+}
+
+func BilawalThaat() Thaat {
+	return Thaat{"Bilawal", []Swara{Sa, Re, Ga, Ma, Pa, Dha, Ni}}
+}
+
+func KalyanThaat() Thaat {
+	return Thaat{"Kalyan", []Swara{Sa, Re, Ga, TivraMa, Pa, Dha, Ni}}
+}
+
+func KhamajThaat() Thaat {
+	return Thaat{"Khamaj", []Swara{Sa, Re, Ga, Ma, Pa, Dha, KomalNi}}
+}
+
+func BhairavThaat() Thaat {
+	return Thaat{"Bhairav", []Swara{Sa, KomalRe, Ga, Ma, Pa, KomalDha, Ni}}
+}
+
+func PoorviThaat() Thaat {
+	return Thaat{"Poorvi", []Swara{Sa, KomalRe, Ga, TivraMa, Pa, KomalDha, Ni}}
+}
+
+func MarwaThaat() Thaat {
+	return Thaat{"Marwa", []Swara{Sa, KomalRe, Ga, TivraMa, Pa, Dha, Ni}}
+}
+
+func KafiThaat() Thaat {
+	return Thaat{"Kafi", []Swara{Sa, Re, KomalGa, Ma, Pa, Dha, KomalNi}}
+}
+
+func AsavariThaat() Thaat {
+	return Thaat{"Asavari", []Swara{Sa, Re, KomalGa, Ma, Pa, KomalDha, KomalNi}}
+}
+
+func BhairaviThaat() Thaat {
+	return Thaat{"Bhairavi", []Swara{Sa, KomalRe, KomalGa, Ma, Pa, KomalDha, KomalNi}}
+}
+
+func TodiThaat() Thaat {
+	return Thaat{"Todi", []Swara{Sa, KomalRe, KomalGa, TivraMa, Pa, KomalDha, Ni}}
+}
+
+func Thaats() []Thaat {
+	return []Thaat{BilawalThaat(), KalyanThaat(), KhamajThaat(), BhairavThaat(), PoorviThaat(), MarwaThaat(), KafiThaat(), AsavariThaat(), BhairaviThaat(), TodiThaat()}
+}
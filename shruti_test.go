@@ -0,0 +1,66 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldReturn22ShrutiScale(t *testing.T) {
+	// Given
+	scale := NewShrutiScale()
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Shruti", scale.System())
+	assert.Equal(t, "Indian 22-shruti just intonation scale.", scale.Description())
+	assert.Equal(t, 23, len(intervals))
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, intervals[0])
+	assert.Equal(t, JustInterval{numerator: 3, denominator: 2}, intervals[13])
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[22])
+	assert.True(t, slicesAreSorted(intervals))
+}
+
+func Test_ShouldNameShrutis(t *testing.T) {
+	assert.Equal(t, "Alapini", ShrutiName(PerfectFifth()))
+	assert.Equal(t, "Marjani", ShrutiName(NewInterval(8, 3)))
+	assert.Equal(t, "", ShrutiName(NewInterval(7, 4)))
+}
+
+func Test_ShouldPlaceSwarasOnShrutisInEachOctave(t *testing.T) {
+	// When
+	ga, _ := Ga.Interval()
+	lowerNi, _ := Ni.Lower().Interval()
+	upperSa, _ := Sa.Upper().Interval()
+	shruti, _ := TivraMa.Shruti()
+	_, err := Swara("Xa").Interval()
+
+	// Then
+	assert.Equal(t, JustInterval{numerator: 5, denominator: 4}, ga)
+	assert.Equal(t, JustInterval{numerator: 15, denominator: 16}, lowerNi)
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, upperSa)
+	assert.Equal(t, "Rakta", shruti.Name())
+	assert.EqualError(t, err, "Xa is not a swara")
+}
+
+func Test_ShouldReturnTenThaatsOfSevenSwaras(t *testing.T) {
+	// When
+	thaats := Thaats()
+
+	// Then
+	assert.Equal(t, 10, len(thaats))
+	for _, thaat := range thaats {
+		assert.Equal(t, 7, len(thaat.Swaras()), thaat.Name())
+	}
+}
+
+func Test_ShouldReturnScaleOfTodiThaat(t *testing.T) {
+	// When
+	scale := TodiThaat().Scale()
+
+	// Then
+	assert.Equal(t, "Todi thaat on the 22 shrutis.", scale.Description())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {16, 15}, {6, 5}, {45, 32}, {3, 2}, {8, 5}, {15, 8}, {2, 1}}), scale.Intervals())
+}
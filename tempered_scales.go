@@ -52,6 +52,14 @@ func (s TemperedScale) Intervals() []TemperedInterval {
 	return s.algorithm()
 }
 
+func (s TemperedScale) Cents() []float64 {
+	var cents []float64
+	for _, interval := range s.Intervals() {
+		cents = append(cents, interval.ToCents())
+	}
+	return cents
+}
+
 type computeTemperedIntervalsFn func() []TemperedInterval
 
 func computeQuarterCommaMeantoneScale() []TemperedInterval {
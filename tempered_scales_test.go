@@ -145,3 +145,14 @@ func Test_ShouldReturnScaleFor19ToneEqualTemperament(t *testing.T) {
 	assert.Equal(t, 1136.84, intervals[18].ToCents())
 	assert.Equal(t, 1200.00, intervals[19].ToCents())
 }
+
+func Test_ShouldReturnCentsOfEachTemperedScaleDegree(t *testing.T) {
+	// When
+	cents := NewEqualTemperamentScale(12).Cents()
+
+	// Then
+	assert.Equal(t, 13, len(cents))
+	for i, c := range cents {
+		assert.Equal(t, float64(i*100), c)
+	}
+}
@@ -0,0 +1,207 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+)
+
+type Genus string
+
+const (
+	EnharmonicGenus Genus = "enharmonic"
+	ChromaticGenus  Genus = "chromatic"
+	DiatonicGenus   Genus = "diatonic"
+)
+
+func (g Genus) String() string {
+	return string(g)
+}
+
+type TetrachordJunction string
+
+const (
+	Conjunct TetrachordJunction = "conjunct"
+	Disjunct TetrachordJunction = "disjunct"
+)
+
+func (j TetrachordJunction) String() string {
+	return string(j)
+}
+
+// Tetrachord three ascending intervals spanning a perfect fourth (4/3), lowest first.
+type Tetrachord struct {
+	name      string
+	genus     Genus
+	intervals []JustInterval
+}
+
+func NewTetrachord(name string, genus Genus, first, second, third JustInterval) (Tetrachord, error) {
+	if !first.Add(second).Add(third).IsPerfectFourth() {
+		return Tetrachord{}, fmt.Errorf("intervals %s, %s and %s of tetrachord %s do not span a perfect fourth", first, second, third, name)
+	}
+	return Tetrachord{name: name, genus: genus, intervals: []JustInterval{first, second, third}}, nil
+}
+
+func (t Tetrachord) Name() string {
+	return t.name
+}
+
+func (t Tetrachord) Genus() Genus {
+	return t.genus
+}
+
+func (t Tetrachord) Intervals() []JustInterval {
+	return t.intervals
+}
+
+func (t Tetrachord) String() string {
+	return fmt.Sprintf("%s (%s %s %s)", t.name, t.intervals[0], t.intervals[1], t.intervals[2])
+}
+
+// NewTetrachordScale joins two tetrachords into an octave, either disjunctly (lower, 9/8, upper) or conjunctly
+// (lower, upper, 9/8), rotated to the requested mode. The unrotated disjunct scale is taken to be in Phrygian mode and
+// the conjunct one in Locrian mode, as they are for the diatonic genus. Only the diatonic modes can be asked for.
+func NewTetrachordScale(lower, upper Tetrachord, junction TetrachordJunction, mode MusicalMode) (JustScale, error) {
+	if _, err := diatonicDegreeOf(mode, 7); err != nil {
+		return JustScale{}, err
+	}
+	return JustScale{
+		system:      "Greek Tetrachordal",
+		description: fmt.Sprintf("%s and %s tetrachords joined %sly in %s mode.", lower.name, upper.name, junction, mode),
+		algorithm: func() []JustInterval {
+			return computeTetrachordScale(lower, upper, junction, mode)
+		},
+	}, nil
+}
+
+func computeTetrachordScale(lower, upper Tetrachord, junction TetrachordJunction, mode MusicalMode) []JustInterval {
+	var steps []JustInterval
+	var unrotatedMode MusicalMode
+	if junction == Conjunct {
+		steps = slices.Concat(lower.intervals, upper.intervals, []JustInterval{GreaterMajorSecond()})
+		unrotatedMode = LocrianMode
+	} else {
+		steps = slices.Concat(lower.intervals, []JustInterval{GreaterMajorSecond()}, upper.intervals)
+		unrotatedMode = PhrygianMode
+	}
+	return rotateJustIntervals(intervalsFromSteps(steps), mode.ionianRotation()-unrotatedMode.ionianRotation())
+}
+
+func intervalsFromSteps(steps []JustInterval) []JustInterval {
+	var interval = Unison()
+	var intervals = []JustInterval{interval}
+	for _, step := range steps {
+		interval = interval.Add(step)
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+func tetrachordOf(name string, genus Genus, ratios [][]uint) Tetrachord {
+	return Tetrachord{name: name, genus: genus, intervals: IntervalsFromIntegers(ratios)}
+}
+
+func ArchytasEnharmonic() Tetrachord {
+	return tetrachordOf("Archytas Enharmonic", EnharmonicGenus, [][]uint{{28, 27}, {36, 35}, {5, 4}})
+}
+
+func ArchytasChromatic() Tetrachord {
+	return tetrachordOf("Archytas Chromatic", ChromaticGenus, [][]uint{{28, 27}, {243, 224}, {32, 27}})
+}
+
+func ArchytasDiatonic() Tetrachord {
+	return tetrachordOf("Archytas Diatonic", DiatonicGenus, [][]uint{{28, 27}, {8, 7}, {9, 8}})
+}
+
+// The Aristoxenian genera are given in parts of a fourth of 30 and expressed here as the string-length divisions
+// Ptolemy tabulated for them, from 120 down to 90.
+
+func AristoxenusEnharmonic() Tetrachord {
+	return tetrachordOf("Aristoxenus Enharmonic", EnharmonicGenus, [][]uint{{40, 39}, {39, 38}, {19, 15}})
+}
+
+func AristoxenusSoftChromatic() Tetrachord {
+	return tetrachordOf("Aristoxenus Soft Chromatic", ChromaticGenus, [][]uint{{30, 29}, {29, 28}, {56, 45}})
+}
+
+func AristoxenusHemiolicChromatic() Tetrachord {
+	return tetrachordOf("Aristoxenus Hemiolic Chromatic", ChromaticGenus, [][]uint{{80, 77}, {77, 74}, {37, 30}})
+}
+
+func AristoxenusIntenseChromatic() Tetrachord {
+	return tetrachordOf("Aristoxenus Intense Chromatic", ChromaticGenus, [][]uint{{20, 19}, {19, 18}, {6, 5}})
+}
+
+func AristoxenusSoftDiatonic() Tetrachord {
+	return tetrachordOf("Aristoxenus Soft Diatonic", DiatonicGenus, [][]uint{{20, 19}, {38, 35}, {7, 6}})
+}
+
+func AristoxenusIntenseDiatonic() Tetrachord {
+	return tetrachordOf("Aristoxenus Intense Diatonic", DiatonicGenus, [][]uint{{20, 19}, {19, 17}, {17, 15}})
+}
+
+func DidymusEnharmonic() Tetrachord {
+	return tetrachordOf("Didymus Enharmonic", EnharmonicGenus, [][]uint{{32, 31}, {31, 30}, {5, 4}})
+}
+
+func DidymusChromatic() Tetrachord {
+	return tetrachordOf("Didymus Chromatic", ChromaticGenus, [][]uint{{16, 15}, {25, 24}, {6, 5}})
+}
+
+func DidymusDiatonic() Tetrachord {
+	return tetrachordOf("Didymus Diatonic", DiatonicGenus, [][]uint{{16, 15}, {10, 9}, {9, 8}})
+}
+
+func EratosthenesEnharmonic() Tetrachord {
+	return tetrachordOf("Eratosthenes Enharmonic", EnharmonicGenus, [][]uint{{40, 39}, {39, 38}, {19, 15}})
+}
+
+func EratosthenesChromatic() Tetrachord {
+	return tetrachordOf("Eratosthenes Chromatic", ChromaticGenus, [][]uint{{20, 19}, {19, 18}, {6, 5}})
+}
+
+func EratosthenesDiatonic() Tetrachord {
+	return tetrachordOf("Eratosthenes Diatonic", DiatonicGenus, [][]uint{{256, 243}, {9, 8}, {9, 8}})
+}
+
+func PtolemyEnharmonic() Tetrachord {
+	return tetrachordOf("Ptolemy Enharmonic", EnharmonicGenus, [][]uint{{46, 45}, {24, 23}, {5, 4}})
+}
+
+func PtolemySoftChromatic() Tetrachord {
+	return tetrachordOf("Ptolemy Soft Chromatic", ChromaticGenus, [][]uint{{28, 27}, {15, 14}, {6, 5}})
+}
+
+func PtolemyIntenseChromatic() Tetrachord {
+	return tetrachordOf("Ptolemy Intense Chromatic", ChromaticGenus, [][]uint{{22, 21}, {12, 11}, {7, 6}})
+}
+
+func PtolemySoftDiatonic() Tetrachord {
+	return tetrachordOf("Ptolemy Soft Diatonic", DiatonicGenus, [][]uint{{21, 20}, {10, 9}, {8, 7}})
+}
+
+func PtolemyTonicDiatonic() Tetrachord {
+	return tetrachordOf("Ptolemy Tonic Diatonic", DiatonicGenus, [][]uint{{28, 27}, {8, 7}, {9, 8}})
+}
+
+func PtolemyDitonicDiatonic() Tetrachord {
+	return tetrachordOf("Ptolemy Ditonic Diatonic", DiatonicGenus, [][]uint{{256, 243}, {9, 8}, {9, 8}})
+}
+
+func PtolemyIntenseDiatonic() Tetrachord {
+	return tetrachordOf("Ptolemy Intense Diatonic", DiatonicGenus, [][]uint{{16, 15}, {9, 8}, {10, 9}})
+}
+
+func PtolemyEquableDiatonic() Tetrachord {
+	return tetrachordOf("Ptolemy Equable Diatonic", DiatonicGenus, [][]uint{{12, 11}, {11, 10}, {10, 9}})
+}
+
+func TetrachordCatalogue() []Tetrachord {
+	return []Tetrachord{
+		ArchytasEnharmonic(), ArchytasChromatic(), ArchytasDiatonic(),
+		AristoxenusEnharmonic(), AristoxenusSoftChromatic(), AristoxenusHemiolicChromatic(), AristoxenusIntenseChromatic(), AristoxenusSoftDiatonic(), AristoxenusIntenseDiatonic(),
+		DidymusEnharmonic(), DidymusChromatic(), DidymusDiatonic(),
+		EratosthenesEnharmonic(), EratosthenesChromatic(), EratosthenesDiatonic(),
+		PtolemyEnharmonic(), PtolemySoftChromatic(), PtolemyIntenseChromatic(), PtolemySoftDiatonic(), PtolemyTonicDiatonic(), PtolemyDitonicDiatonic(), PtolemyIntenseDiatonic(), PtolemyEquableDiatonic(),
+	}
+}
@@ -0,0 +1,83 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldSpanPerfectFourthForEveryTetrachordInCatalogue(t *testing.T) {
+	for _, tetrachord := range TetrachordCatalogue() {
+		t.Run(tetrachord.Name(), func(t *testing.T) {
+			intervals := tetrachord.Intervals()
+			assert.Equal(t, 3, len(intervals))
+			assert.True(t, intervals[0].Add(intervals[1]).Add(intervals[2]).IsPerfectFourth())
+		})
+	}
+}
+
+func Test_ShouldRejectTetrachordThatDoesNotSpanPerfectFourth(t *testing.T) {
+	// When
+	_, err := NewTetrachord("Broken", DiatonicGenus, GreaterMajorSecond(), GreaterMajorSecond(), GreaterMajorSecond())
+
+	// Then
+	assert.EqualError(t, err, "intervals 9:8, 9:8 and 9:8 of tetrachord Broken do not span a perfect fourth")
+}
+
+func Test_ShouldAcceptBespokeTetrachord(t *testing.T) {
+	// When
+	tetrachord, err := NewTetrachord("Bespoke", ChromaticGenus, DiatonicSemitone(), JustChromaticSemitone(), NewInterval(6, 5))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "Bespoke (16:15 25:24 6:5)", tetrachord.String())
+	assert.Equal(t, ChromaticGenus, tetrachord.Genus())
+}
+
+func Test_ShouldReturnDisjunctPtolemyIntenseDiatonicScaleInPhrygianMode(t *testing.T) {
+	// Given
+	scale, _ := NewTetrachordScale(PtolemyIntenseDiatonic(), PtolemyIntenseDiatonic(), Disjunct, PhrygianMode)
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Greek Tetrachordal", scale.System())
+	assert.Equal(t, "Ptolemy Intense Diatonic and Ptolemy Intense Diatonic tetrachords joined disjunctly in Phrygian mode.", scale.Description())
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {16, 15}, {6, 5}, {4, 3}, {3, 2}, {8, 5}, {9, 5}, {2, 1}}), intervals)
+}
+
+func Test_ShouldMatchIntenseDiatonicScaleWhenPtolemyIntenseDiatonicTetrachordsAreJoinedInIonianMode(t *testing.T) {
+	// When
+	scale, _ := NewTetrachordScale(PtolemyIntenseDiatonic(), PtolemyIntenseDiatonic(), Disjunct, IonianMode)
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, NewIntenseDiatonicScale(IonianMode).Intervals(), intervals)
+}
+
+func Test_ShouldReturnConjunctArchytasEnharmonicScale(t *testing.T) {
+	// When
+	scale, _ := NewTetrachordScale(ArchytasEnharmonic(), ArchytasEnharmonic(), Conjunct, LocrianMode)
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {28, 27}, {16, 15}, {4, 3}, {112, 81}, {64, 45}, {16, 9}, {2, 1}}), intervals)
+}
+
+func Test_ShouldRotateConjunctScaleToDorianMode(t *testing.T) {
+	// When
+	scale, _ := NewTetrachordScale(PtolemyDitonicDiatonic(), PtolemyDitonicDiatonic(), Conjunct, DorianMode)
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, IntervalsFromIntegers([][]uint{{1, 1}, {9, 8}, {32, 27}, {4, 3}, {3, 2}, {27, 16}, {16, 9}, {2, 1}}), intervals)
+}
+
+func Test_ShouldReturnErrorWhenTetrachordsAreJoinedInNonDiatonicMode(t *testing.T) {
+	// When
+	_, err := NewTetrachordScale(PtolemyIntenseDiatonic(), PtolemyIntenseDiatonic(), Disjunct, HarmonicMinorMode)
+
+	// Then
+	assert.EqualError(t, err, "Harmonic Minor is not a diatonic mode")
+}
@@ -0,0 +1,126 @@
+package music
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TonalityDiamond Harry Partch's tonality diamond: each row is the otonality over one odd identity
+// and each column the utonality under one, with every ratio octave reduced.
+// Reference: Harry Partch, Genesis of a Music (2nd ed., 1974), chapter 8
+type TonalityDiamond struct {
+	identities []uint
+	matrix     [][]JustInterval
+}
+
+// NewTonalityDiamond fails unless it is given at least one identity and every identity is odd and distinct.
+func NewTonalityDiamond(identities ...uint) (TonalityDiamond, error) {
+	if len(identities) == 0 {
+		return TonalityDiamond{}, fmt.Errorf("tonality diamond needs at least one odd identity")
+	}
+	if slices.Contains(identities, 0) {
+		return TonalityDiamond{}, fmt.Errorf("tonality diamond identities must be at least 1 but got %v", identities)
+	}
+	for i, identity := range identities {
+		if identity%2 == 0 {
+			return TonalityDiamond{}, fmt.Errorf("tonality diamond identities must be odd but got %d in %v", identity, identities)
+		}
+		if slices.Contains(identities[:i], identity) {
+			return TonalityDiamond{}, fmt.Errorf("tonality diamond identities must be distinct but %d appears more than once in %v", identity, identities)
+		}
+	}
+	identities = slices.Clone(identities)
+	var otonalMultipliers, utonalMultipliers [][]uint
+	for _, identity := range identities {
+		otonalMultipliers = append(otonalMultipliers, []uint{identity, 1})
+		utonalMultipliers = append(utonalMultipliers, []uint{1, identity})
+	}
+
+	var matrix [][]JustInterval
+	var row []JustInterval
+	for _, multiplier := range createMultiplierTableOf(utonalMultipliers, otonalMultipliers) {
+		row = append(row, JustInterval{numerator: multiplier[0], denominator: multiplier[1]}.OctaveReduce().Simplify())
+		if len(row) == len(identities) {
+			matrix = append(matrix, row)
+			row = nil
+		}
+	}
+
+	return TonalityDiamond{identities: identities, matrix: matrix}, nil
+}
+
+func (d TonalityDiamond) Identities() []uint {
+	return slices.Clone(d.identities)
+}
+
+// Matrix returns the diamond with the otonality over identities[i] in row i and the utonality under identities[j] in column j.
+func (d TonalityDiamond) Matrix() [][]JustInterval {
+	return d.matrix
+}
+
+func (d TonalityDiamond) Otonality(identity uint) []JustInterval {
+	for i, id := range d.identities {
+		if id == identity {
+			return slices.Clone(d.matrix[i])
+		}
+	}
+	return nil
+}
+
+func (d TonalityDiamond) Utonality(identity uint) []JustInterval {
+	for j, id := range d.identities {
+		if id == identity {
+			var utonality []JustInterval
+			for _, row := range d.matrix {
+				utonality = append(utonality, row[j])
+			}
+			return utonality
+		}
+	}
+	return nil
+}
+
+func (d TonalityDiamond) Intervals() []JustInterval {
+	var intervals []JustInterval
+	for _, row := range d.matrix {
+		for _, interval := range row {
+			intervals = appendIfMissing(intervals, interval)
+		}
+	}
+	SortIntervals(intervals)
+	return append(intervals, Octave())
+}
+
+func (d TonalityDiamond) Scale() JustScale {
+	return JustScale{
+		system:      "Tonality Diamond",
+		description: fmt.Sprintf("Partch tonality diamond of the odd identities %v.", d.identities),
+		algorithm:   d.Intervals,
+	}
+}
+
+func NewPartch43Scale() JustScale {
+	return JustScale{
+		system:      "Partch 43-tone",
+		description: "Harry Partch's 43-tone 11-limit just intonation gamut.",
+		algorithm:   computePartch43Scale,
+	}
+}
+
+func computePartch43Scale() []JustInterval {
+	return IntervalsFromIntegers([][]uint{
+		{1, 1}, {81, 80}, {33, 32}, {21, 20}, {16, 15}, {12, 11}, {11, 10}, {10, 9}, {9, 8}, {8, 7}, {7, 6},
+		{32, 27}, {6, 5}, {11, 9}, {5, 4}, {14, 11}, {9, 7}, {21, 16}, {4, 3}, {27, 20}, {11, 8}, {7, 5},
+		{10, 7}, {16, 11}, {40, 27}, {3, 2}, {32, 21}, {14, 9}, {11, 7}, {8, 5}, {18, 11}, {5, 3}, {27, 16},
+		{12, 7}, {7, 4}, {16, 9}, {9, 5}, {20, 11}, {11, 6}, {15, 8}, {40, 21}, {64, 33}, {160, 81}, {2, 1},
+	})
+}
+
+func appendIfMissing(intervals []JustInterval, interval JustInterval) []JustInterval {
+	for _, existing := range intervals {
+		if existing.IsEqualTo(interval) {
+			return intervals
+		}
+	}
+	return append(intervals, interval)
+}
@@ -0,0 +1,119 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShouldReturnOtonalitiesAndUtonalitiesOf5LimitTonalityDiamond(t *testing.T) {
+	// Given
+	diamond, _ := NewTonalityDiamond(1, 3, 5)
+
+	// When
+	matrix := diamond.Matrix()
+
+	// Then
+	assert.Equal(t, [][]JustInterval{
+		{{numerator: 1, denominator: 1}, {numerator: 3, denominator: 2}, {numerator: 5, denominator: 4}},
+		{{numerator: 4, denominator: 3}, {numerator: 1, denominator: 1}, {numerator: 5, denominator: 3}},
+		{{numerator: 8, denominator: 5}, {numerator: 6, denominator: 5}, {numerator: 1, denominator: 1}},
+	}, matrix)
+	assert.Equal(t, []JustInterval{{numerator: 1, denominator: 1}, {numerator: 3, denominator: 2}, {numerator: 5, denominator: 4}}, diamond.Otonality(1))
+	assert.Equal(t, []JustInterval{{numerator: 1, denominator: 1}, {numerator: 4, denominator: 3}, {numerator: 8, denominator: 5}}, diamond.Utonality(1))
+	assert.Nil(t, diamond.Otonality(7))
+}
+
+func Test_ShouldReturnDeduplicatedSortedScaleOf11LimitTonalityDiamond(t *testing.T) {
+	// Given
+	diamond, _ := NewTonalityDiamond(1, 3, 5, 7, 9, 11)
+	scale := diamond.Scale()
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Tonality Diamond", scale.System())
+	assert.Equal(t, "Partch tonality diamond of the odd identities [1 3 5 7 9 11].", scale.Description())
+	assert.Equal(t, 30, len(intervals))
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, intervals[0])
+	assert.Equal(t, JustInterval{numerator: 12, denominator: 11}, intervals[1])
+	assert.Equal(t, JustInterval{numerator: 11, denominator: 10}, intervals[2])
+	assert.Equal(t, JustInterval{numerator: 3, denominator: 2}, intervals[17])
+	assert.Equal(t, JustInterval{numerator: 11, denominator: 6}, intervals[28])
+	assert.Equal(t, JustInterval{numerator: 20, denominator: 11}, intervals[27])
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[29])
+}
+
+func Test_ShouldReturnPartch43ToneScale(t *testing.T) {
+	// Given
+	scale := NewPartch43Scale()
+
+	// When
+	intervals := scale.Intervals()
+
+	// Then
+	assert.Equal(t, "Partch 43-tone", scale.System())
+	assert.Equal(t, "Harry Partch's 43-tone 11-limit just intonation gamut.", scale.Description())
+	assert.Equal(t, 44, len(intervals))
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, intervals[0])
+	assert.Equal(t, JustInterval{numerator: 81, denominator: 80}, intervals[1])
+	assert.Equal(t, JustInterval{numerator: 160, denominator: 81}, intervals[42])
+	assert.Equal(t, JustInterval{numerator: 2, denominator: 1}, intervals[43])
+	diamond, _ := NewTonalityDiamond(1, 3, 5, 7, 9, 11)
+	for _, interval := range diamond.Intervals() {
+		assert.Contains(t, intervals, interval)
+	}
+	assert.True(t, slicesAreSorted(intervals))
+}
+
+func slicesAreSorted(intervals []JustInterval) bool {
+	for i := 1; i < len(intervals); i++ {
+		if !intervals[i-1].LessThan(intervals[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func Test_ShouldReturnErrorForZeroIdentityInTonalityDiamond(t *testing.T) {
+	// When
+	_, err := NewTonalityDiamond(1, 0, 5)
+
+	// Then
+	assert.EqualError(t, err, "tonality diamond identities must be at least 1 but got [1 0 5]")
+}
+
+func Test_ShouldRejectEmptyEvenAndDuplicateIdentitiesInTonalityDiamond(t *testing.T) {
+	tests := []struct {
+		name       string
+		identities []uint
+		wantErr    string
+	}{
+		{name: "empty", identities: nil, wantErr: "tonality diamond needs at least one odd identity"},
+		{name: "even", identities: []uint{1, 3, 4}, wantErr: "tonality diamond identities must be odd but got 4 in [1 3 4]"},
+		{name: "duplicate", identities: []uint{1, 3, 5, 3}, wantErr: "tonality diamond identities must be distinct but 3 appears more than once in [1 3 5 3]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTonalityDiamond(tt.identities...)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_ShouldNotLetCallersChangeTonalityDiamond(t *testing.T) {
+	// Given
+	identities := []uint{1, 3, 5}
+	diamond, _ := NewTonalityDiamond(identities...)
+
+	// When
+	identities[1] = 7
+	diamond.Identities()[2] = 9
+	diamond.Otonality(3)[0] = JustInterval{numerator: 7, denominator: 4}
+
+	// Then
+	assert.Equal(t, []uint{1, 3, 5}, diamond.Identities())
+	assert.Equal(t, JustInterval{numerator: 1, denominator: 1}, diamond.Otonality(3)[1])
+	assert.Equal(t, diamond.Matrix()[1], diamond.Otonality(3))
+}